@@ -0,0 +1,103 @@
+package chars
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCopyUint64Backward(t *testing.T) {
+	for _, c := range []struct {
+		name          string
+		in            uint64
+		size          int
+		expected      string
+		expectedStart int
+	}{
+		{"min", 0, 4, "0", 3},
+		{"mid", u64mid, 12, "1844674407", 2},
+		{"max", u64max, 20, dec64max, 0},
+		{"short", u64max, 18, "", -2},
+		{"empty", 0, 0, "", -1},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := make([]byte, c.size)
+			actualStart := CopyUint64Backward(buf, c.in)
+
+			if actualStart != c.expectedStart {
+				t.Fatalf("expected [%d], got [%d]", c.expectedStart, actualStart)
+			}
+
+			if actualStart < 0 {
+				for _, b := range buf {
+					if b != 0 {
+						t.Fatalf("expected dst to be left untouched, got [%q]", buf)
+					}
+				}
+				return
+			}
+
+			if string(buf[actualStart:]) != c.expected {
+				t.Errorf("expected [%s], got [%s]", c.expected, buf[actualStart:])
+			}
+		})
+	}
+}
+
+func TestCopyBackwardWidths(t *testing.T) {
+	// Build a record back to front: "<u8>,<i16>,<u32>,<i64>".
+	buf := make([]byte, 64)
+	end := len(buf)
+
+	end = CopyInt64Backward(buf[:end], int64Min)
+	end--
+	buf[end] = ','
+	end = CopyUint32Backward(buf[:end], u32max)
+	end--
+	buf[end] = ','
+	end = CopyInt16Backward(buf[:end], -42)
+	end--
+	buf[end] = ','
+	end = CopyUint8Backward(buf[:end], u8max)
+
+	expected := "255,-42,4294967295,-9223372036854775808"
+	if string(buf[end:]) != expected {
+		t.Errorf("expected [%s], got [%s]", expected, buf[end:])
+	}
+
+	for u := 0; u <= u8max; u++ {
+		b := make([]byte, 3)
+		i := CopyUint8Backward(b, uint8(u))
+		if string(b[i:]) != strconv.Itoa(u) {
+			t.Fatalf("expected [%d], got [%s]", u, b[i:])
+		}
+
+		b = make([]byte, 4)
+		j := CopyInt8Backward(b, int8(-u/2))
+		if string(b[j:]) != strconv.Itoa(-u/2) {
+			t.Fatalf("expected [%d], got [%s]", -u/2, b[j:])
+		}
+	}
+
+	if i := CopyUint16Backward(make([]byte, 4), u16max); i != -1 {
+		t.Errorf("expected [-1], got [%d]", i)
+	}
+
+	if i := CopyInt32Backward(make([]byte, 10), int32Min); i != -1 {
+		t.Errorf("expected [-1], got [%d]", i)
+	}
+
+	b := make([]byte, 8)
+	if i := CopyInt8Backward(b, int8Min); string(b[i:]) != deci8min {
+		t.Errorf("expected [%s], got [%s]", deci8min, b[i:])
+	}
+}
+
+func BenchmarkRushCopyUint64Backward(b *testing.B) {
+	by := make([]byte, 20)
+	for n := 0; n < b.N; n++ {
+		_ = CopyUint64Backward(by, uint64Max)
+	}
+}
@@ -0,0 +1,248 @@
+package chars
+
+import (
+	"math/big"
+	"math/bits"
+)
+
+// Decimal conversions for math/big.Int.
+//
+// Like the 128-bit conversions, these work in chunks of 19 digits handled by ParseUint64 and
+// the LUT-based formatting routine of CopyUint64. Values of up to bigSplitChunks chunks get
+// converted chunk by chunk with plain 64-bit multiplications and divisions on the magnitude's
+// words. Larger values get split in halves at a power of 10^19 first (divide and conquer),
+// so that the asymptotically faster multiplication and division of math/big do the heavy
+// lifting.
+
+// Number of chunks above which values get split in halves.
+const bigSplitChunks = 48
+
+// ParseBigInt takes a signed integer of arbitrary size encoded as base10 (decimal) with an
+// optional leading '-' or '+' sign and converts it to a *big.Int.
+//
+// Accepts exactly the inputs new(big.Int).SetString(s, 10) accepts.
+// If the string contains non-numeric ASCII characters (besides the leading sign) or consists
+// of only the sign, nil and false get returned.
+func ParseBigInt(s string) (*big.Int, bool) {
+	if len(s) == 0 {
+		return nil, false
+	}
+
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	if len(s) == 0 {
+		return nil, false
+	}
+
+	var (
+		z    = new(big.Int)
+		pows bigPow10
+	)
+
+	if !parseBig(z, s, &pows) {
+		return nil, false
+	}
+
+	if neg {
+		z.Neg(z)
+	}
+
+	return z, true
+}
+
+// AppendBigInt appends the base10 representation of x to dst and returns the extended buffer,
+// growing it if necessary. Negative values get prefixed with '-'.
+//
+// Produces exactly the output of x.String() (including "<nil>" for a nil x).
+func AppendBigInt(dst []byte, x *big.Int) []byte {
+	if x == nil {
+		return append(dst, "<nil>"...)
+	}
+
+	if x.Sign() < 0 {
+		dst = append(dst, '-')
+	}
+
+	// An upper bound of the number of digits: 1234/4096 is slightly above log10(2).
+	n := x.BitLen()*1234>>12 + 1
+	dst = grow(dst, n)
+	l := len(dst)
+	b := dst[l : l+n]
+
+	var pows bigPow10
+	formatBig(b, x.Bits(), &pows)
+
+	// The digits are zero-padded to n - drop the padding, but keep a single 0.
+	i := 0
+	for i < n-1 && b[i] == '0' {
+		i++
+	}
+
+	return dst[:l+copy(b, b[i:])]
+}
+
+// bigPow10 lazily caches 10^(19 * 2^i), the split points of the divide-and-conquer
+// conversions.
+type bigPow10 []*big.Int
+
+func (p *bigPow10) get(i int) *big.Int {
+	for len(*p) <= i {
+		if len(*p) == 0 {
+			*p = append(*p, new(big.Int).SetUint64(chunkPow10))
+			continue
+		}
+
+		last := (*p)[len(*p)-1]
+		*p = append(*p, new(big.Int).Mul(last, last))
+	}
+
+	return (*p)[i]
+}
+
+// bigSplit returns i such that 19 * 2^i digits is the largest split point that leaves
+// the upper half of n digits non-empty.
+func bigSplit(n int) (i, digits int) {
+	i = bits.Len(uint((n-1)/chunkDigits)) - 1
+	return i, chunkDigits << i
+}
+
+// parseBig sets z to the value of the digits in s and reports whether s consists of
+// digits only.
+func parseBig(z *big.Int, s string, pows *bigPow10) bool {
+	if len(s) > bigSplitChunks*chunkDigits {
+		i, n := bigSplit(len(s))
+
+		lo := new(big.Int)
+		if !parseBig(z, s[:len(s)-n], pows) || !parseBig(lo, s[len(s)-n:], pows) {
+			return false
+		}
+
+		z.Mul(z, pows.get(i))
+		z.Add(z, lo)
+
+		return true
+	}
+
+	// The leading chunk takes the odd digits, all others are exactly chunkDigits long and
+	// hence can't overflow in ParseUint64.
+	n := len(s) % chunkDigits
+	if n == 0 {
+		n = chunkDigits
+	}
+
+	var buf [bigSplitChunks]uint64
+	limbs := buf[:0]
+
+	for ; len(s) > 0; s, n = s[n:], chunkDigits {
+		v, ok := ParseUint64(s[:n])
+		if !ok {
+			return false
+		}
+
+		// limbs = limbs*10^19 + v.
+		carry := v
+		for j, l := range limbs {
+			hi, lo := bits.Mul64(l, chunkPow10)
+			lo, c := bits.Add64(lo, carry, 0)
+			limbs[j], carry = lo, hi+c
+		}
+
+		if carry != 0 {
+			limbs = append(limbs, carry)
+		}
+	}
+
+	z.SetBits(limbsToWords(limbs))
+
+	return true
+}
+
+// formatBig writes the base10 representation of the magnitude x into b, right-aligned and
+// zero-padded to len(b), which must have enough room for all digits.
+func formatBig(b []byte, x []big.Word, pows *bigPow10) {
+	if len(b) > bigSplitChunks*chunkDigits {
+		i, n := bigSplit(len(b))
+
+		q, r := new(big.Int).QuoRem(new(big.Int).SetBits(x), pows.get(i), new(big.Int))
+		formatBig(b[len(b)-n:], r.Bits(), pows)
+		formatBig(b[:len(b)-n], q.Bits(), pows)
+
+		return
+	}
+
+	var buf [bigSplitChunks]uint64
+	limbs := wordsToLimbs(buf[:0], x)
+
+	end := len(b)
+	for len(limbs) > 0 {
+		// limbs, r = limbs / 10^19, limbs % 10^19.
+		var r uint64
+		for j := len(limbs) - 1; j >= 0; j-- {
+			limbs[j], r = bits.Div64(r, limbs[j], chunkPow10)
+		}
+
+		if limbs[len(limbs)-1] == 0 {
+			limbs = limbs[:len(limbs)-1]
+		}
+
+		i := formatUint64(b[:end], r)
+		if len(limbs) > 0 {
+			// Chunks below the leading one keep their zeros.
+			copyZeros(b[end-chunkDigits:i], chunkDigits)
+			i = end - chunkDigits
+		}
+
+		end = i
+	}
+
+	copyZeros(b, end)
+}
+
+// limbsToWords converts 64-bit limbs (least significant first) to the words of a big.Int.
+func limbsToWords(limbs []uint64) []big.Word {
+	if bits.UintSize == 64 {
+		w := make([]big.Word, len(limbs))
+		for i, l := range limbs {
+			w[i] = big.Word(l)
+		}
+
+		return w
+	}
+
+	w := make([]big.Word, 2*len(limbs))
+	for i, l := range limbs {
+		w[2*i], w[2*i+1] = big.Word(l), big.Word(l>>32)
+	}
+
+	return w
+}
+
+// wordsToLimbs appends the words of a big.Int as 64-bit limbs (least significant first)
+// to limbs.
+func wordsToLimbs(limbs []uint64, x []big.Word) []uint64 {
+	if bits.UintSize == 64 {
+		for _, w := range x {
+			limbs = append(limbs, uint64(w))
+		}
+
+		return limbs
+	}
+
+	for i := 0; i < len(x); i += 2 {
+		l := uint64(x[i])
+		if i+1 < len(x) {
+			l |= uint64(x[i+1]) << 32
+		}
+
+		limbs = append(limbs, l)
+	}
+
+	return limbs
+}
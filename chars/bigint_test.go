@@ -0,0 +1,126 @@
+package chars
+
+import (
+	"math/big"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestParseBigInt(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expectedOk bool
+	}{
+		{"empty", "", false},
+		{"sign-only", "-", false},
+		{"plus-only", "+", false},
+		{"zero", "0", true},
+		{"neg-zero", "-0", true},
+		{"plus", "+42", true},
+		{"u64max", dec64max, true},
+		{"u128max", dec128max, true},
+		{"neg", deci128min, true},
+		{"leading-zeros", "-000000000000000000000000000000000000000000123", true},
+		{"chunk-boundary", "1" + strings.Repeat("0", 38), true},
+		{"large", strings.Repeat("9", 5000), true},
+		{"large-neg", "-1" + strings.Repeat("0", 4999), true},
+		{"syntax", "12345678901234567890x", false},
+		{"syntax-large", strings.Repeat("1", 3000) + "x" + strings.Repeat("1", 3000), false},
+		{"syntax-double-sign", "--1", false},
+		{"syntax-underscore", "1_000", false},
+		{"syntax-space", " 1", false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			expected, _ := new(big.Int).SetString(c.in, 10)
+			actual, actualOk := ParseBigInt(c.in)
+
+			if actualOk != c.expectedOk {
+				t.Fatalf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+
+			if !actualOk {
+				if actual != nil {
+					t.Errorf("expected [nil], got [%v]", actual)
+				}
+
+				return
+			}
+
+			if actual.Cmp(expected) != 0 {
+				t.Errorf("expected [%v], got [%v]", expected, actual)
+			}
+		})
+	}
+}
+
+func TestAppendBigInt(t *testing.T) {
+	if actual := AppendBigInt([]byte("x"), nil); string(actual) != "x<nil>" {
+		t.Errorf("expected [x<nil>], got [%s]", actual)
+	}
+
+	for _, in := range []string{"0", "-1", dec64max, dec128max, deci128min, "1" + strings.Repeat("0", 38), strings.Repeat("9", 5000)} {
+		x, _ := new(big.Int).SetString(in, 10)
+		if actual := AppendBigInt([]byte("x"), x); string(actual) != "x"+in {
+			t.Errorf("expected [x%s], got [%s]", in, actual)
+		}
+	}
+}
+
+// TestBigIntRandom checks both directions against math/big for sizes on both sides of
+// the divide-and-conquer threshold.
+func TestBigIntRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(5))
+
+	for i := 0; i < 2000; i++ {
+		x := new(big.Int).Rand(rng, new(big.Int).Lsh(big.NewInt(1), uint(rng.Intn(12000))))
+		if rng.Intn(2) == 0 {
+			x.Neg(x)
+		}
+
+		expected := x.String()
+		if actual := string(AppendBigInt(nil, x)); actual != expected {
+			t.Fatalf("expected [%s], got [%s]", expected, actual)
+		}
+
+		if actual, ok := ParseBigInt(expected); !ok || actual.Cmp(x) != 0 {
+			t.Fatalf("[%s]: expected [%v, true], got [%v, %t]", expected, x, actual, ok)
+		}
+	}
+}
+
+var decBig = strings.Repeat("1234567890", 15)
+
+func BenchmarkBigParseBigInt(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = new(big.Int).SetString(decBig, 10)
+	}
+}
+
+func BenchmarkRushParseBigInt(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseBigInt(decBig)
+	}
+}
+
+func BenchmarkBigAppendBigInt(b *testing.B) {
+	x, _ := new(big.Int).SetString(decBig, 10)
+	dst := make([]byte, 0, 256)
+
+	for n := 0; n < b.N; n++ {
+		_ = x.Append(dst, 10)
+	}
+}
+
+func BenchmarkRushAppendBigInt(b *testing.B) {
+	x, _ := new(big.Int).SetString(decBig, 10)
+	dst := make([]byte, 0, 256)
+
+	for n := 0; n < b.N; n++ {
+		_ = AppendBigInt(dst, x)
+	}
+}
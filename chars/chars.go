@@ -1,5 +1,19 @@
+// Package chars provides typed, allocation-free conversions between integers and their
+// ASCII representations.
+//
+// Parsers return a (value, bool) pair instead of an error, unless noted otherwise.
+//
+// Copy functions write into dst starting at its beginning and never grow it. If dst is too
+// short, the representation gets truncated at len(dst) - the leading bytes are kept and all
+// overflowing bytes discarded, e.g. 12345 copied into a 3-byte buffer yields "123". This
+// holds for all widths and signs alike. Since a truncated number is indistinguishable from
+// a shorter one, use the Checked variants (or size dst via the DecimalLen family) wherever
+// dst is not guaranteed to be large enough. The Padded variants are the only exception, as
+// they produce fixed-width fields and keep the trailing digits instead.
 package chars
 
+import "unsafe"
+
 const (
 	uint64Digits = 20
 	uint64Max    = 1<<64 - 1
@@ -15,4 +29,25 @@ const (
 
 	uint8Max    = 1<<8 - 1
 	uint8Digits = 3
+
+	int64Max = 1<<63 - 1
+	int64Min = -1 << 63
+
+	int32Max = 1<<31 - 1
+	int32Min = -1 << 31
+
+	int16Max = 1<<15 - 1
+	int16Min = -1 << 15
+
+	int8Max = 1<<7 - 1
+	int8Min = -1 << 7
 )
+
+// bytesToString returns a string sharing the underlying memory of b, without copying.
+//
+// Only safe to use as long as the result does not outlive the call it gets passed to and b
+// does not get mutated in the meantime - which holds for all the parsers, as none of them
+// retain their input.
+func bytesToString(b []byte) string {
+	return *(*string)(unsafe.Pointer(&b))
+}
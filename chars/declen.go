@@ -0,0 +1,97 @@
+package chars
+
+import "math/bits"
+
+// pow10 holds all powers of 10 representable by a uint64.
+var pow10 = [uint64Digits]uint64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+}
+
+// DecimalLen64 returns the number of digits in the base10 representation of u,
+// e.g. the number of bytes CopyUint64 needs to write it in full.
+//
+// The log10 gets approximated from the bit length (1233/4096 being close to log10(2)),
+// which is either exact or one too low - a single comparison against the power of 10
+// sorts that out.
+func DecimalLen64(u uint64) int {
+	// u|1 has the same digit count as u, except for 0 which now counts as 1 digit.
+	u |= 1
+	t := bits.Len64(u) * 1233 >> 12
+	if u >= pow10[t] {
+		t++
+	}
+
+	return t
+}
+
+// DecimalLen32 returns the number of digits in the base10 representation of u,
+// e.g. the number of bytes CopyUint32 needs to write it in full.
+func DecimalLen32(u uint32) int {
+	u |= 1
+	t := bits.Len32(u) * 1233 >> 12
+	if uint64(u) >= pow10[t] {
+		t++
+	}
+
+	return t
+}
+
+// DecimalLen16 returns the number of digits in the base10 representation of u,
+// e.g. the number of bytes CopyUint16 needs to write it in full.
+func DecimalLen16(u uint16) int {
+	return DecimalLen32(uint32(u))
+}
+
+// DecimalLen8 returns the number of digits in the base10 representation of u,
+// e.g. the number of bytes CopyUint8 needs to write it in full.
+func DecimalLen8(u uint8) int {
+	switch {
+	case u < 10:
+		return 1
+	case u < 100:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// DecimalLenInt64 returns the number of bytes in the base10 representation of i,
+// including the '-' sign of negative values.
+func DecimalLenInt64(i int64) int {
+	if i < 0 {
+		return 1 + DecimalLen64(uint64(-i))
+	}
+
+	return DecimalLen64(uint64(i))
+}
+
+// DecimalLenInt32 returns the number of bytes in the base10 representation of i,
+// including the '-' sign of negative values.
+func DecimalLenInt32(i int32) int {
+	if i < 0 {
+		return 1 + DecimalLen32(uint32(-i))
+	}
+
+	return DecimalLen32(uint32(i))
+}
+
+// DecimalLenInt16 returns the number of bytes in the base10 representation of i,
+// including the '-' sign of negative values.
+func DecimalLenInt16(i int16) int {
+	if i < 0 {
+		return 1 + DecimalLen16(uint16(-i))
+	}
+
+	return DecimalLen16(uint16(i))
+}
+
+// DecimalLenInt8 returns the number of bytes in the base10 representation of i,
+// including the '-' sign of negative values.
+func DecimalLenInt8(i int8) int {
+	if i < 0 {
+		return 1 + DecimalLen8(uint8(-i))
+	}
+
+	return DecimalLen8(uint8(i))
+}
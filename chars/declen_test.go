@@ -0,0 +1,74 @@
+package chars
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestDecimalLen(t *testing.T) {
+	// All powers of 10 and their neighbours cover every boundary.
+	in := []uint64{0, uint64Max}
+	for _, p := range pow10 {
+		in = append(in, p-1, p, p+1)
+	}
+
+	for _, u := range in {
+		expected := len(strconv.FormatUint(u, 10))
+
+		if actual := DecimalLen64(u); actual != expected {
+			t.Errorf("[%d]: expected [%d], got [%d]", u, expected, actual)
+		}
+
+		if u <= uint32Max {
+			if actual := DecimalLen32(uint32(u)); actual != expected {
+				t.Errorf("[%d]: expected [%d], got [%d]", u, expected, actual)
+			}
+		}
+
+		if u <= uint16Max {
+			if actual := DecimalLen16(uint16(u)); actual != expected {
+				t.Errorf("[%d]: expected [%d], got [%d]", u, expected, actual)
+			}
+		}
+
+		if u <= uint8Max {
+			if actual := DecimalLen8(uint8(u)); actual != expected {
+				t.Errorf("[%d]: expected [%d], got [%d]", u, expected, actual)
+			}
+		}
+	}
+}
+
+func TestDecimalLenInt(t *testing.T) {
+	for _, i := range []int64{0, -1, 9, -9, 10, -10, int8Min, int8Max, int16Min, int16Max, int32Min, int32Max, int64Min, int64Max} {
+		expected := len(strconv.FormatInt(i, 10))
+
+		if actual := DecimalLenInt64(i); actual != expected {
+			t.Errorf("[%d]: expected [%d], got [%d]", i, expected, actual)
+		}
+
+		if i >= int32Min && i <= int32Max {
+			if actual := DecimalLenInt32(int32(i)); actual != expected {
+				t.Errorf("[%d]: expected [%d], got [%d]", i, expected, actual)
+			}
+		}
+
+		if i >= int16Min && i <= int16Max {
+			if actual := DecimalLenInt16(int16(i)); actual != expected {
+				t.Errorf("[%d]: expected [%d], got [%d]", i, expected, actual)
+			}
+		}
+
+		if i >= int8Min && i <= int8Max {
+			if actual := DecimalLenInt8(int8(i)); actual != expected {
+				t.Errorf("[%d]: expected [%d], got [%d]", i, expected, actual)
+			}
+		}
+	}
+}
+
+func BenchmarkDecimalLen64(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_ = DecimalLen64(uint64Max)
+	}
+}
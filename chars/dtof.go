@@ -0,0 +1,458 @@
+package chars
+
+//go:generate go run pow10_gen.go
+
+import (
+	"math"
+	"math/bits"
+	"strconv"
+)
+
+// Decimal-string-to-float conversions.
+//
+// The common case - a plain decimal with at most 19 significant digits and a moderate
+// exponent - gets handled by scanning the mantissa with the same digit loop as ParseUint64
+// (including its SWAR path) followed by either an exact float multiplication (Clinger's fast
+// path) or the Eisel-Lemire algorithm. Everything else (hex floats, inf/nan, inputs too long
+// or too ambiguous for Eisel-Lemire to decide and malformed input) gets deferred to
+// strconv.ParseFloat, so that exactly the same inputs get accepted and rejected as by strconv
+// and all results are correctly rounded.
+
+const (
+	float64MantBits = 52
+	float64Bias     = 1023
+	float32MantBits = 23
+	float32Bias     = 127
+
+	// Max number of significant digits that fit in the mantissa accumulator.
+	floatMantDigits = 19
+)
+
+// float64Pow10 holds the powers of 10 exactly representable as float64.
+var float64Pow10 = [...]float64{
+	1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9,
+	1e10, 1e11, 1e12, 1e13, 1e14, 1e15, 1e16, 1e17, 1e18, 1e19,
+	1e20, 1e21, 1e22,
+}
+
+// float32Pow10 holds the powers of 10 exactly representable as float32.
+var float32Pow10 = [...]float32{1e0, 1e1, 1e2, 1e3, 1e4, 1e5, 1e6, 1e7, 1e8, 1e9, 1e10}
+
+// ParseFloat64 takes a floating-point number encoded as base10 (decimal) and converts it to
+// the nearest float64, rounding half to even.
+//
+// Accepts exactly the inputs strconv.ParseFloat(s, 64) accepts.
+// If the value is out of range, ±Inf and false get returned.
+// If the string is not a valid number, 0 and false get returned.
+func ParseFloat64(s string) (float64, bool) {
+	man, exp10, neg, trunc, ok := scanFloat(s)
+	if !ok {
+		return parseFloatSlow(s, 64)
+	}
+
+	if !trunc {
+		if f, ok := float64Exact(man, exp10, neg); ok {
+			return f, true
+		}
+	}
+
+	f, ok := eiselLemire64(man, exp10, neg)
+	if !ok {
+		return parseFloatSlow(s, 64)
+	}
+
+	// With the mantissa truncated, the true value lies somewhere between man and man+1 (both
+	// scaled by 10^exp10). If both round to the same float, that's the result regardless.
+	if trunc {
+		if g, ok := eiselLemire64(man+1, exp10, neg); !ok || g != f {
+			return parseFloatSlow(s, 64)
+		}
+	}
+
+	return f, true
+}
+
+// ParseFloat32 takes a floating-point number encoded as base10 (decimal) and converts it to
+// the nearest float32, rounding half to even.
+//
+// Accepts exactly the inputs strconv.ParseFloat(s, 32) accepts.
+// If the value is out of range, ±Inf and false get returned.
+// If the string is not a valid number, 0 and false get returned.
+func ParseFloat32(s string) (float32, bool) {
+	man, exp10, neg, trunc, ok := scanFloat(s)
+	if !ok {
+		f, ok := parseFloatSlow(s, 32)
+		return float32(f), ok
+	}
+
+	if !trunc {
+		if f, ok := float32Exact(man, exp10, neg); ok {
+			return f, true
+		}
+	}
+
+	f, ok := eiselLemire32(man, exp10, neg)
+	if !ok {
+		f, ok := parseFloatSlow(s, 32)
+		return float32(f), ok
+	}
+
+	if trunc {
+		if g, ok := eiselLemire32(man+1, exp10, neg); !ok || g != f {
+			f, ok := parseFloatSlow(s, 32)
+			return float32(f), ok
+		}
+	}
+
+	return f, true
+}
+
+// scanFloat scans s as [+-]digits[.digits][(e|E)[+-]digits], where at least one digit must
+// be present in the mantissa.
+//
+// Returns the first (up to) 19 significant digits as man and the decimal exponent to scale
+// them by, whether any non-zero digits got truncated and false if s does not match the
+// syntax (which does not necessarily mean s is invalid - see ParseFloat64).
+func scanFloat(s string) (man uint64, exp10 int, neg, trunc, ok bool) {
+	var (
+		i      int
+		nd     int // Significant digits seen, including the truncated ones.
+		ndMant int // Significant digits in man.
+		dp     int // Position of the decimal point relative to the significant digits.
+		sawDot bool
+		sawDig bool
+	)
+
+	if len(s) > 0 {
+		switch s[0] {
+		case '-':
+			neg = true
+			i++
+		case '+':
+			i++
+		}
+	}
+
+	for ; i < len(s); i++ {
+		c := s[i]
+
+		if c == '.' {
+			if sawDot {
+				return
+			}
+
+			sawDot = true
+			dp = nd
+			continue
+		}
+
+		if c-'0' > 9 {
+			break
+		}
+
+		sawDig = true
+
+		if nd == 0 && c == '0' {
+			// Leading zeros are not significant, but shift the decimal point.
+			dp--
+			continue
+		}
+
+		// Runs of 8 digits go through the SWAR path of ParseUint64 while they fit.
+		if ndMant > 0 && ndMant+8 <= floatMantDigits && len(s)-i >= 8 {
+			if v, ok := parseEightDigits(loadEight(s[i:])); ok {
+				man = man*1e8 + v
+				nd += 8
+				ndMant += 8
+				i += 7
+				continue
+			}
+		}
+
+		nd++
+
+		if ndMant < floatMantDigits {
+			man = man*10 + uint64(c-'0')
+			ndMant++
+		} else if c != '0' {
+			trunc = true
+		}
+	}
+
+	if !sawDig {
+		return
+	}
+
+	if !sawDot {
+		dp = nd
+	}
+
+	if i < len(s) && s[i]|0x20 == 'e' {
+		i++
+
+		var eneg bool
+		if i < len(s) {
+			switch s[i] {
+			case '-':
+				eneg = true
+				i++
+			case '+':
+				i++
+			}
+		}
+
+		if i == len(s) || s[i]-'0' > 9 {
+			return
+		}
+
+		var e int
+		for ; i < len(s) && s[i]-'0' <= 9; i++ {
+			// Anything beyond is going to over- or underflow either way.
+			if e < 10000 {
+				e = e*10 + int(s[i]-'0')
+			}
+		}
+
+		if eneg {
+			e = -e
+		}
+
+		dp += e
+	}
+
+	if i != len(s) {
+		return
+	}
+
+	if man != 0 {
+		exp10 = dp - ndMant
+	}
+
+	return man, exp10, neg, trunc, true
+}
+
+// float64Exact converts man * 10^exp10 exactly, if both man and the power of 10 are exactly
+// representable as float64 - in which case a single (correctly rounded) multiplication or
+// division yields the correctly rounded result.
+func float64Exact(man uint64, exp10 int, neg bool) (float64, bool) {
+	if man>>(float64MantBits+1) != 0 {
+		return 0, false
+	}
+
+	f := float64(man)
+	if neg {
+		f = -f
+	}
+
+	switch {
+	case exp10 == 0:
+		return f, true
+
+	case exp10 > 0 && exp10 <= 15+22:
+		// If the mantissa is small enough, part of the exponent can be moved into it
+		// while keeping it exact, e.g. 1e30 as 1e8 * 1e22.
+		if exp10 > 22 {
+			f *= float64Pow10[exp10-22]
+			exp10 = 22
+		}
+
+		if f > 1e15 || f < -1e15 {
+			return 0, false
+		}
+
+		return f * float64Pow10[exp10], true
+
+	case exp10 < 0 && exp10 >= -22:
+		return f / float64Pow10[-exp10], true
+	}
+
+	return 0, false
+}
+
+// float32Exact is float64Exact for float32.
+func float32Exact(man uint64, exp10 int, neg bool) (float32, bool) {
+	if man>>(float32MantBits+1) != 0 {
+		return 0, false
+	}
+
+	f := float32(man)
+	if neg {
+		f = -f
+	}
+
+	switch {
+	case exp10 == 0:
+		return f, true
+
+	case exp10 > 0 && exp10 <= 7+10:
+		if exp10 > 10 {
+			f *= float32Pow10[exp10-10]
+			exp10 = 10
+		}
+
+		if f > 1e7 || f < -1e7 {
+			return 0, false
+		}
+
+		return f * float32Pow10[exp10], true
+
+	case exp10 < 0 && exp10 >= -10:
+		return f / float32Pow10[-exp10], true
+	}
+
+	return 0, false
+}
+
+// eiselLemire64 converts man * 10^exp10 to the nearest float64 using the Eisel-Lemire
+// algorithm: the normalized mantissa gets multiplied by the 128-bit mantissa of the power
+// of 10, which suffices to determine the correctly rounded result in almost all cases.
+//
+// Returns false if it can't decide (the product is too close to a halfway point), or the
+// result is subnormal, infinite or out of the table's range.
+func eiselLemire64(man uint64, exp10 int, neg bool) (float64, bool) {
+	if man == 0 {
+		if neg {
+			return math.Copysign(0, -1), true
+		}
+
+		return 0, true
+	}
+
+	if exp10 < pow10MinExp || exp10 > pow10MaxExp {
+		return 0, false
+	}
+
+	// Normalize, so the most significant bit is set.
+	clz := bits.LeadingZeros64(man)
+	man <<= uint(clz)
+
+	// 217706/65536 approximates log2(10).
+	exp2 := uint64(217706*exp10>>16+64+float64Bias) - uint64(clz)
+
+	pow := &pow10Mantissas[exp10-pow10MinExp]
+	hi, lo := bits.Mul64(man, pow[1])
+
+	// If the lower 9 bits of hi are all set, the truncated lower half of the power's
+	// mantissa may carry into them - so it needs to be taken into account.
+	if hi&0x1FF == 0x1FF && lo+man < man {
+		yhi, ylo := bits.Mul64(man, pow[0])
+
+		mhi, mlo := hi, lo+yhi
+		if mlo < lo {
+			mhi++
+		}
+
+		if mhi&0x1FF == 0x1FF && mlo+1 == 0 && ylo+man < man {
+			return 0, false
+		}
+
+		hi, lo = mhi, mlo
+	}
+
+	// Shift down to 54 bits - 53 for the mantissa plus one for rounding.
+	msb := hi >> 63
+	m := hi >> (msb + 9)
+	exp2 -= 1 ^ msb
+
+	// Exactly halfway between two floats - can't tell which way to round.
+	if lo == 0 && hi&0x1FF == 0 && m&3 == 1 {
+		return 0, false
+	}
+
+	// Round to 53 bits. The rounding may carry into a 54th bit.
+	m += m & 1
+	m >>= 1
+	if m>>53 > 0 {
+		m >>= 1
+		exp2++
+	}
+
+	// exp2 of 0 (or wrapped around) means subnormal, 0x7FF or above means Inf/NaN.
+	if exp2-1 >= 0x7FF-1 {
+		return 0, false
+	}
+
+	b := exp2<<float64MantBits | m&(1<<float64MantBits-1)
+	if neg {
+		b |= 1 << 63
+	}
+
+	return math.Float64frombits(b), true
+}
+
+// eiselLemire32 is eiselLemire64 for float32.
+func eiselLemire32(man uint64, exp10 int, neg bool) (float32, bool) {
+	if man == 0 {
+		if neg {
+			return float32(math.Copysign(0, -1)), true
+		}
+
+		return 0, true
+	}
+
+	if exp10 < pow10MinExp || exp10 > pow10MaxExp {
+		return 0, false
+	}
+
+	clz := bits.LeadingZeros64(man)
+	man <<= uint(clz)
+
+	exp2 := uint64(217706*exp10>>16+64+float32Bias) - uint64(clz)
+
+	pow := &pow10Mantissas[exp10-pow10MinExp]
+	hi, lo := bits.Mul64(man, pow[1])
+
+	// Same as eiselLemire64, except 38 bits get discarded instead of 9.
+	if hi&0x3FFFFFFFFF == 0x3FFFFFFFFF && lo+man < man {
+		yhi, ylo := bits.Mul64(man, pow[0])
+
+		mhi, mlo := hi, lo+yhi
+		if mlo < lo {
+			mhi++
+		}
+
+		if mhi&0x3FFFFFFFFF == 0x3FFFFFFFFF && mlo+1 == 0 && ylo+man < man {
+			return 0, false
+		}
+
+		hi, lo = mhi, mlo
+	}
+
+	msb := hi >> 63
+	m := hi >> (msb + 38)
+	exp2 -= 1 ^ msb
+
+	if lo == 0 && hi&0x3FFFFFFFFF == 0 && m&3 == 1 {
+		return 0, false
+	}
+
+	m += m & 1
+	m >>= 1
+	if m>>24 > 0 {
+		m >>= 1
+		exp2++
+	}
+
+	if exp2-1 >= 0xFF-1 {
+		return 0, false
+	}
+
+	b := uint32(exp2<<float32MantBits | m&(1<<float32MantBits-1))
+	if neg {
+		b |= 1 << 31
+	}
+
+	return math.Float32frombits(b), true
+}
+
+// parseFloatSlow defers to strconv.ParseFloat, translating its error into the bool of the
+// package's API.
+func parseFloatSlow(s string, bitSize int) (float64, bool) {
+	f, err := strconv.ParseFloat(s, bitSize)
+	if err != nil {
+		// On range errors f is ±Inf, on syntax errors 0 - which is what gets returned.
+		return f, false
+	}
+
+	return f, true
+}
@@ -0,0 +1,213 @@
+package chars
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+const (
+	decFloat     = "3.141592653589793"
+	decFloatExp  = "6.02214076e23"
+	decFloatLong = "2.2250738585072011360574097967091319759348195463516456480234261098828e-308"
+)
+
+func TestParseFloat64(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expected   float64
+		expectedOk bool
+	}{
+		{"empty", "", 0, false},
+		{"zero", "0", 0, true},
+		{"int", "42", 42, true},
+		{"frac", "0.5", 0.5, true},
+		{"pi", decFloat, math.Pi, true},
+		{"neg", "-1.25", -1.25, true},
+		{"plus", "+1.25", 1.25, true},
+		{"lead-dot", ".5", 0.5, true},
+		{"trail-dot", "5.", 5, true},
+		{"exp", decFloatExp, 6.02214076e23, true},
+		{"exp-upper", "1E-7", 1e-7, true},
+		{"exp-plus", "1e+7", 1e7, true},
+		{"max", "1.7976931348623157e308", math.MaxFloat64, true},
+		{"smallest", "5e-324", math.SmallestNonzeroFloat64, true},
+		{"underflow", "1e-400", 0, true},
+		{"overflow", "1e400", math.Inf(1), false},
+		{"overflow-neg", "-1e400", math.Inf(-1), false},
+		{"inf", "-Inf", math.Inf(-1), true},
+		{"infinity", "infinity", math.Inf(1), true},
+		{"hex", "0x1p-2", 0.25, true},
+		{"long", decFloatLong, 2.2250738585072014e-308, true},
+		{"halfway", "9007199254740993", 9007199254740992, true},
+		{"syntax", "1.2.3", 0, false},
+		{"syntax-exp", "1e", 0, false},
+		{"syntax-exp-sign", "1e+", 0, false},
+		{"syntax-sign", "-", 0, false},
+		{"syntax-dot", ".", 0, false},
+		{"underscore", "1_000.5", 1000.5, true},
+		{"syntax-underscore", "1__0", 0, false},
+		{"syntax-space", " 1", 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseFloat64(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%v], got [%v]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParseFloat64NegZero(t *testing.T) {
+	for _, in := range []string{"-0", "-0.0", "-0e10"} {
+		if f, ok := ParseFloat64(in); !ok || f != 0 || !math.Signbit(f) {
+			t.Errorf("[%s]: expected [-0, true], got [%v, %t]", in, f, ok)
+		}
+
+		if f, ok := ParseFloat32(in); !ok || f != 0 || !math.Signbit(float64(f)) {
+			t.Errorf("[%s]: expected [-0, true], got [%v, %t]", in, f, ok)
+		}
+	}
+}
+
+// floatInputs generates inputs of all shapes the fast paths handle, along with
+// some that they must hand off.
+func floatInputs(rng *rand.Rand, n int) []string {
+	in := make([]string, 0, n)
+
+	for len(in) < n {
+		switch rng.Intn(5) {
+		case 0:
+			f := math.Float64frombits(rng.Uint64())
+			in = append(in, strconv.FormatFloat(f, 'g', -1, 64))
+		case 1:
+			f := math.Float64frombits(rng.Uint64())
+			in = append(in, strconv.FormatFloat(f, 'e', rng.Intn(25), 64))
+		case 2:
+			f := float64(math.Float32frombits(rng.Uint32()))
+			in = append(in, strconv.FormatFloat(f, 'g', -1, 32))
+		case 3:
+			// Random digits with a random decimal point and exponent, up to 30 digits long.
+			b := make([]byte, 1+rng.Intn(30))
+			for i := range b {
+				b[i] = '0' + byte(rng.Intn(10))
+			}
+
+			s := string(b)
+			if p := rng.Intn(len(b) + 1); p < len(b) {
+				s = s[:p] + "." + s[p:]
+			}
+
+			in = append(in, s+"e"+strconv.Itoa(rng.Intn(700)-350))
+		case 4:
+			f := rng.Float64() * math.Pow(10, float64(rng.Intn(40)-20))
+			in = append(in, strconv.FormatFloat(f, 'f', rng.Intn(20), 64))
+		}
+	}
+
+	return in
+}
+
+func TestParseFloat64Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for _, in := range floatInputs(rng, 200000) {
+		expected, err := strconv.ParseFloat(in, 64)
+		actual, ok := ParseFloat64(in)
+
+		if ok != (err == nil) || math.Float64bits(actual) != math.Float64bits(expected) && !(math.IsNaN(actual) && math.IsNaN(expected)) {
+			t.Fatalf("[%s]: expected [%v, %v], got [%v, %t]", in, expected, err, actual, ok)
+		}
+	}
+}
+
+func TestParseFloat32Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(2))
+
+	for _, in := range floatInputs(rng, 200000) {
+		expected, err := strconv.ParseFloat(in, 32)
+		actual, ok := ParseFloat32(in)
+
+		if ok != (err == nil) || math.Float32bits(actual) != math.Float32bits(float32(expected)) && !(actual != actual && expected != expected) {
+			t.Fatalf("[%s]: expected [%v, %v], got [%v, %t]", in, float32(expected), err, actual, ok)
+		}
+	}
+}
+
+func TestParseFloat32(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expected   float32
+		expectedOk bool
+	}{
+		{"empty", "", 0, false},
+		{"int", "42", 42, true},
+		{"pi", decFloat, math.Pi, true},
+		{"max", "3.4028234663852886e38", math.MaxFloat32, true},
+		{"overflow", "1e39", float32(math.Inf(1)), false},
+		{"double-rounding", "1.00000005960464477539062499", 1, true},
+		{"syntax", "1..", 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseFloat32(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%v], got [%v]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func BenchmarkStrconvParseFloat64(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseFloat(decFloat, 64)
+	}
+}
+
+func BenchmarkRushParseFloat64(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseFloat64(decFloat)
+	}
+}
+
+func BenchmarkStrconvParseFloat64Exp(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseFloat(decFloatExp, 64)
+	}
+}
+
+func BenchmarkRushParseFloat64Exp(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseFloat64(decFloatExp)
+	}
+}
+
+func BenchmarkStrconvParseFloat32(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseFloat(decFloat, 32)
+	}
+}
+
+func BenchmarkRushParseFloat32(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseFloat32(decFloat)
+	}
+}
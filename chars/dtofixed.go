@@ -0,0 +1,186 @@
+package chars
+
+import "math/bits"
+
+// Decimal-string-to-scaled-integer conversions, the inverse of CopyFixed64 and friends.
+//
+// The integer part and the first scale digits of the fraction get parsed by ParseUint64 -
+// including its overflow detection - and then combined as integer * 10^scale + fraction.
+// Fraction digits past the scale only get looked at for rounding.
+
+// Rounding selects what happens to fraction digits beyond the scale.
+type Rounding uint8
+
+const (
+	// RoundTruncate drops the excess digits, i.e. rounds toward zero.
+	RoundTruncate Rounding = iota
+
+	// RoundHalfEven rounds to the nearest value, ties to the even one (banker's rounding).
+	RoundHalfEven
+
+	// RoundHalfUp rounds to the nearest value, ties away from zero (commercial rounding).
+	RoundHalfUp
+
+	// RoundExact rejects inputs with non-zero digits beyond the scale.
+	RoundExact
+)
+
+// Max scale at which 10^scale still fits into a uint64.
+const fixedMaxScale = uint64Digits - 1
+
+// ParseUfixed64 takes an unsigned decimal number with an optional fraction, e.g. "1234.56",
+// and converts it to a uint64 scaled by 10^scale, e.g. 123456 for a scale of 2. Fraction
+// digits beyond the scale get rounded according to r.
+//
+// The scale must be in the range of 0 to 19.
+// If the scaled value overflows, uint64Max and false get returned.
+// If the string is not of the form digits[.digits], the scale is out of range or r is
+// RoundExact and the fraction has non-zero digits beyond the scale, 0 and false get returned.
+func ParseUfixed64(s string, scale int, r Rounding) (uint64, bool) {
+	if uint(scale) > fixedMaxScale {
+		return 0, false
+	}
+
+	integer, frac := s, ""
+	for i := 0; i < len(s); i++ {
+		if s[i] == '.' {
+			integer, frac = s[:i], s[i+1:]
+			if len(frac) == 0 {
+				return 0, false
+			}
+
+			break
+		}
+	}
+
+	u, ok := ParseUint64(integer)
+	if !ok {
+		return u, false
+	}
+
+	var (
+		excess string
+		f      uint64
+	)
+
+	if len(frac) > scale {
+		frac, excess = frac[:scale], frac[scale:]
+	}
+
+	if len(frac) > 0 {
+		if f, ok = ParseUint64(frac); !ok {
+			// At most 19 digits, so this can only be a syntax error.
+			return 0, false
+		}
+
+		f *= pow10[scale-len(frac)]
+	}
+
+	// The parity of the scaled value: 10^scale is even for all scales but 0.
+	odd := f&1 == 1
+	if scale == 0 {
+		odd = u&1 == 1
+	}
+
+	up, ok := roundFixed(excess, odd, r)
+	if !ok {
+		return 0, false
+	}
+
+	hi, lo := bits.Mul64(u, pow10[scale])
+	lo, c1 := bits.Add64(lo, f, 0)
+	lo, c2 := bits.Add64(lo, up, 0)
+	if hi|c1|c2 != 0 {
+		return uint64Max, false
+	}
+
+	return lo, true
+}
+
+// ParseFixed64 takes a signed decimal number with an optional leading '-' or '+' sign and
+// an optional fraction, e.g. "-1234.56", and converts it to an int64 scaled by 10^scale,
+// e.g. -123456 for a scale of 2. Fraction digits beyond the scale get rounded according to
+// r, with the sign not affecting the magnitude (-0.5 rounds to -1 with RoundHalfUp).
+//
+// The scale must be in the range of 0 to 19.
+// If the scaled value overflows, int64Min or int64Max (depending on the sign) and false get
+// returned.
+// If the string is not of the form [sign]digits[.digits], the scale is out of range or r is
+// RoundExact and the fraction has non-zero digits beyond the scale, 0 and false get returned.
+func ParseFixed64(s string, scale int, r Rounding) (int64, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	u, ok := ParseUfixed64(s, scale, r)
+	if !ok {
+		if u == 0 {
+			return 0, false
+		}
+
+		u = uint64Max
+	}
+
+	if neg {
+		if u > -int64Min {
+			return int64Min, false
+		}
+
+		return -int64(u), true
+	}
+
+	if u > int64Max {
+		return int64Max, false
+	}
+
+	return int64(u), true
+}
+
+// roundFixed returns 1 if the (odd or even) scaled magnitude needs to be rounded up according
+// to the excess digits and r, or 0 otherwise. Returns false if excess contains non-digits or
+// r is RoundExact and excess is not all zeros.
+func roundFixed(excess string, odd bool, r Rounding) (uint64, bool) {
+	var (
+		first byte
+		rest  bool // Any non-zero digit after the first one.
+	)
+
+	for i := 0; i < len(excess); i++ {
+		d := excess[i] - '0'
+		if d > 9 {
+			return 0, false
+		}
+
+		if i == 0 {
+			first = d
+		} else if d != 0 {
+			rest = true
+		}
+	}
+
+	switch r {
+	case RoundHalfUp:
+		if first >= 5 {
+			return 1, true
+		}
+	case RoundHalfEven:
+		if first > 5 || first == 5 && (rest || odd) {
+			return 1, true
+		}
+	case RoundExact:
+		if first != 0 || rest {
+			return 0, false
+		}
+	}
+
+	return 0, true
+}
@@ -0,0 +1,126 @@
+package chars
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestParseFixed64(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		scale      int
+		r          Rounding
+		expected   int64
+		expectedOk bool
+	}{
+		{"empty", "", 2, RoundTruncate, 0, false},
+		{"sign-only", "-", 2, RoundTruncate, 0, false},
+		{"int", "12", 2, RoundTruncate, 1200, true},
+		{"cents", "1234.56", 2, RoundTruncate, 123456, true},
+		{"neg", "-0.5", 2, RoundTruncate, -50, true},
+		{"plus", "+0.05", 2, RoundTruncate, 5, true},
+		{"short-frac", "1.5", 3, RoundExact, 1500, true},
+		{"scale-zero", "42", 0, RoundExact, 42, true},
+		{"scale-max", "0.9223372036854775807", 19, RoundExact, int64Max, true},
+		{"truncate", "1.239", 2, RoundTruncate, 123, true},
+		{"truncate-neg", "-1.239", 2, RoundTruncate, -123, true},
+		{"half-up", "1.235", 2, RoundHalfUp, 124, true},
+		{"half-up-below", "1.2349", 2, RoundHalfUp, 123, true},
+		{"half-up-neg", "-0.5", 0, RoundHalfUp, -1, true},
+		{"half-even-down", "1.225", 2, RoundHalfEven, 122, true},
+		{"half-even-up", "1.235", 2, RoundHalfEven, 124, true},
+		{"half-even-above", "1.2251", 2, RoundHalfEven, 123, true},
+		{"half-even-scale-zero", "2.5", 0, RoundHalfEven, 2, true},
+		{"half-even-scale-zero-odd", "3.5", 0, RoundHalfEven, 4, true},
+		{"half-up-carry", "9.995", 2, RoundHalfUp, 1000, true},
+		{"exact-zeros", "1.2300", 2, RoundExact, 123, true},
+		{"exact", "1.231", 2, RoundExact, 0, false},
+		{"min", "-922337203685477.5808", 4, RoundExact, int64Min, true},
+		{"max", "922337203685477.5807", 4, RoundExact, int64Max, true},
+		{"overflow-min", "-922337203685477.5809", 4, RoundExact, int64Min, false},
+		{"overflow-max", "922337203685477.5808", 4, RoundExact, int64Max, false},
+		{"overflow-round", "922337203685477.58075", 4, RoundHalfUp, int64Max, false},
+		{"overflow-int", "99999999999999999999999", 2, RoundTruncate, int64Max, false},
+		{"scale-neg", "1", -1, RoundTruncate, 0, false},
+		{"scale-large", "1", 20, RoundTruncate, 0, false},
+		{"syntax", "1.2x", 2, RoundTruncate, 0, false},
+		{"syntax-excess", "1.23x", 2, RoundTruncate, 0, false},
+		{"syntax-dot", "1.", 2, RoundTruncate, 0, false},
+		{"syntax-lead-dot", ".5", 2, RoundTruncate, 0, false},
+		{"syntax-double-dot", "1.2.3", 2, RoundTruncate, 0, false},
+		{"syntax-frac-sign", "1.-2", 2, RoundTruncate, 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseFixed64(c.in, c.scale, c.r)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParseUfixed64(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		scale      int
+		r          Rounding
+		expected   uint64
+		expectedOk bool
+	}{
+		{"cents", "1234.56", 2, RoundTruncate, 123456, true},
+		{"sign", "-1", 2, RoundTruncate, 0, false},
+		{"max", "1844674407370955161.5", 1, RoundExact, uint64Max, true},
+		{"overflow", "1844674407370955161.6", 1, RoundExact, uint64Max, false},
+		{"overflow-round", "1844674407370955161.55", 1, RoundHalfUp, uint64Max, false},
+		{"overflow-int", "18446744073709551616", 0, RoundExact, uint64Max, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseUfixed64(c.in, c.scale, c.r)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestFixed64RoundTrip(t *testing.T) {
+	for _, i := range []int64{0, 1, -1, 5, -123456, int64Min, int64Max} {
+		for scale := 0; scale <= fixedMaxScale; scale++ {
+			s := string(AppendFixed64(nil, i, scale, FixedFormat{}))
+			if actual, ok := ParseFixed64(s, scale, RoundExact); !ok || actual != i {
+				t.Errorf("[%s]: expected [%d, true], got [%d, %t]", s, i, actual, ok)
+			}
+		}
+	}
+}
+
+func BenchmarkStrconvParseFixed64(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		f, _ := strconv.ParseFloat("1234.56", 64)
+		_ = int64(f * 100)
+	}
+}
+
+func BenchmarkRushParseFixed64(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseFixed64("1234.56", 2, RoundExact)
+	}
+}
@@ -0,0 +1,227 @@
+package chars
+
+// Typed decimal-string-to-signed-int conversions.
+//
+// These are thin wrappers around the ParseUint family - the sign gets stripped, the magnitude
+// gets parsed by the unsigned parser of the same width and then range checked against the
+// asymmetric bounds of the signed type. The magnitude of the min value of a signed N-bit integer
+// always fits into an unsigned N-bit integer, so no wider intermediate is needed.
+
+// ParseInt64 takes a signed integer encoded as base10 (decimal) with an optional leading
+// '-' or '+' sign and converts it to a signed 64-bit integer.
+//
+// The max length of the string is 21 characters (including the sign, not counting leading
+// zeros) and the value of the number must be in the range of int64Min (-9223372036854775808) to
+// int64Max (9223372036854775807).
+// If either overflows, int64Min or int64Max (depending on the sign) and false get returned.
+// If the string contains non-numeric ASCII characters (besides the leading sign) or consists
+// of only the sign, 0 and false get returned.
+func ParseInt64(s string) (int64, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	u, ok := ParseUint64(s)
+	if !ok {
+		if u == 0 {
+			// Syntax error.
+			return 0, false
+		}
+
+		u = uint64Max
+	}
+
+	if neg {
+		// The magnitude of int64Min is int64Max+1, which when converted back
+		// yields int64Min again, so negation is safe for the whole range.
+		if u > -int64Min {
+			return int64Min, false
+		}
+
+		return -int64(u), true
+	}
+
+	if u > int64Max {
+		return int64Max, false
+	}
+
+	return int64(u), true
+}
+
+// ParseInt32 takes a signed integer encoded as base10 (decimal) with an optional leading
+// '-' or '+' sign and converts it to a signed 32-bit integer.
+//
+// The max length of the string is 11 characters (including the sign, not counting leading
+// zeros) and the value of the number must be in the range of int32Min (-2147483648) to
+// int32Max (2147483647).
+// If either overflows, int32Min or int32Max (depending on the sign) and false get returned.
+// If the string contains non-numeric ASCII characters (besides the leading sign) or consists
+// of only the sign, 0 and false get returned.
+func ParseInt32(s string) (int32, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	u, ok := ParseUint32(s)
+	if !ok {
+		if u == 0 {
+			return 0, false
+		}
+
+		u = uint32Max
+	}
+
+	if neg {
+		if u > -int32Min {
+			return int32Min, false
+		}
+
+		return -int32(u), true
+	}
+
+	if u > int32Max {
+		return int32Max, false
+	}
+
+	return int32(u), true
+}
+
+// ParseInt16 takes a signed integer encoded as base10 (decimal) with an optional leading
+// '-' or '+' sign and converts it to a signed 16-bit integer.
+//
+// The max length of the string is 6 characters (including the sign, not counting leading
+// zeros) and the value of the number must be in the range of int16Min (-32768) to
+// int16Max (32767).
+// If either overflows, int16Min or int16Max (depending on the sign) and false get returned.
+// If the string contains non-numeric ASCII characters (besides the leading sign) or consists
+// of only the sign, 0 and false get returned.
+func ParseInt16(s string) (int16, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	u, ok := ParseUint16(s)
+	if !ok {
+		if u == 0 {
+			return 0, false
+		}
+
+		u = uint16Max
+	}
+
+	if neg {
+		if u > -int16Min {
+			return int16Min, false
+		}
+
+		return -int16(u), true
+	}
+
+	if u > int16Max {
+		return int16Max, false
+	}
+
+	return int16(u), true
+}
+
+// ParseInt8 takes a signed integer encoded as base10 (decimal) with an optional leading
+// '-' or '+' sign and converts it to a signed 8-bit integer.
+//
+// The max length of the string is 4 characters (including the sign, not counting leading
+// zeros) and the value of the number must be in the range of int8Min (-128) to
+// int8Max (127).
+// If either overflows, int8Min or int8Max (depending on the sign) and false get returned.
+// If the string contains non-numeric ASCII characters (besides the leading sign) or consists
+// of only the sign, 0 and false get returned.
+func ParseInt8(s string) (int8, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	u, ok := ParseUint8(s)
+	if !ok {
+		if u == 0 {
+			return 0, false
+		}
+
+		u = uint8Max
+	}
+
+	if neg {
+		if u > -int8Min {
+			return int8Min, false
+		}
+
+		return -int8(u), true
+	}
+
+	if u > int8Max {
+		return int8Max, false
+	}
+
+	return int8(u), true
+}
+
+// ParseInt64Bytes works like ParseInt64 but takes a byte slice, e.g. straight out of a read buffer.
+//
+// The input does not get converted (copied) to a string, so this never allocates.
+func ParseInt64Bytes(b []byte) (int64, bool) {
+	return ParseInt64(bytesToString(b))
+}
+
+// ParseInt32Bytes works like ParseInt32 but takes a byte slice, e.g. straight out of a read buffer.
+//
+// The input does not get converted (copied) to a string, so this never allocates.
+func ParseInt32Bytes(b []byte) (int32, bool) {
+	return ParseInt32(bytesToString(b))
+}
+
+// ParseInt16Bytes works like ParseInt16 but takes a byte slice, e.g. straight out of a read buffer.
+//
+// The input does not get converted (copied) to a string, so this never allocates.
+func ParseInt16Bytes(b []byte) (int16, bool) {
+	return ParseInt16(bytesToString(b))
+}
+
+// ParseInt8Bytes works like ParseInt8 but takes a byte slice, e.g. straight out of a read buffer.
+//
+// The input does not get converted (copied) to a string, so this never allocates.
+func ParseInt8Bytes(b []byte) (int8, bool) {
+	return ParseInt8(bytesToString(b))
+}
@@ -0,0 +1,252 @@
+package chars
+
+import (
+	"strconv"
+	"testing"
+)
+
+const (
+	deci64min = "-9223372036854775808"
+	deci64max = "9223372036854775807"
+	deci64mid = "-922337203"
+	deci32min = "-2147483648"
+	deci32max = "2147483647"
+	deci16min = "-32768"
+	deci16max = "32767"
+	deci8min  = "-128"
+	deci8max  = "127"
+)
+
+func TestParseInt64(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expected   int64
+		expectedOk bool
+	}{
+		{"empty", "", 0, false},
+		{"sign-only", "-", 0, false},
+		{"plus-only", "+", 0, false},
+		{"zero", "0", 0, true},
+		{"neg-zero", "-0", 0, true},
+		{"plus", "+42", 42, true},
+		{"mid", deci64mid, -922337203, true},
+		{"min", deci64min, int64Min, true},
+		{"max", deci64max, int64Max, true},
+		{"overflow-min", "-9223372036854775809", int64Min, false},
+		{"overflow-max", "9223372036854775808", int64Max, false},
+		{"overflow-len", "-984467440737095516150", int64Min, false},
+		{"leading-zeros", "-0000" + deci64min[1:], int64Min, true},
+		{"overflow-uint", "18446744073709551615", int64Max, false},
+		{"syntax", "-92233d", 0, false},
+		{"syntax-double-sign", "--1", 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseInt64(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParseInt32(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expected   int32
+		expectedOk bool
+	}{
+		{"empty", "", 0, false},
+		{"sign-only", "-", 0, false},
+		{"zero", "0", 0, true},
+		{"plus", "+7", 7, true},
+		{"min", deci32min, int32Min, true},
+		{"max", deci32max, int32Max, true},
+		{"overflow-min", "-2147483649", int32Min, false},
+		{"overflow-max", "2147483648", int32Max, false},
+		{"overflow-len", "-42949672950", int32Min, false},
+		{"syntax", "-2x", 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseInt32(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParseInt16(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expected   int16
+		expectedOk bool
+	}{
+		{"empty", "", 0, false},
+		{"sign-only", "+", 0, false},
+		{"zero", "-0", 0, true},
+		{"min", deci16min, int16Min, true},
+		{"max", deci16max, int16Max, true},
+		{"overflow-min", "-32769", int16Min, false},
+		{"overflow-max", "32768", int16Max, false},
+		{"overflow-len", "655355", int16Max, false},
+		{"syntax", "-3a", 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseInt16(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParseInt8(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expected   int8
+		expectedOk bool
+	}{
+		{"empty", "", 0, false},
+		{"sign-only", "-", 0, false},
+		{"zero", "0", 0, true},
+		{"digit", "-9", -9, true},
+		{"min", deci8min, int8Min, true},
+		{"max", deci8max, int8Max, true},
+		{"overflow-min", "-129", int8Min, false},
+		{"overflow-max", "128", int8Max, false},
+		{"overflow-uint", "-256", int8Min, false},
+		{"overflow-len", "-2555", int8Min, false},
+		{"leading-zeros", "-0000128", int8Min, true},
+		{"leading-zeros-overflow", "+0000128", int8Max, false},
+		{"syntax", "-1a", 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseInt8(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParseIntBytes(t *testing.T) {
+	for _, in := range []string{"", "-", "0", "+9", deci8min, deci16min, deci32min, deci64min, deci64max, "-1a"} {
+		b := []byte(in)
+
+		v64, ok64 := ParseInt64(in)
+		if v, ok := ParseInt64Bytes(b); v != v64 || ok != ok64 {
+			t.Errorf("[%s]: expected [%d, %t], got [%d, %t]", in, v64, ok64, v, ok)
+		}
+
+		v32, ok32 := ParseInt32(in)
+		if v, ok := ParseInt32Bytes(b); v != v32 || ok != ok32 {
+			t.Errorf("[%s]: expected [%d, %t], got [%d, %t]", in, v32, ok32, v, ok)
+		}
+
+		v16, ok16 := ParseInt16(in)
+		if v, ok := ParseInt16Bytes(b); v != v16 || ok != ok16 {
+			t.Errorf("[%s]: expected [%d, %t], got [%d, %t]", in, v16, ok16, v, ok)
+		}
+
+		v8, ok8 := ParseInt8(in)
+		if v, ok := ParseInt8Bytes(b); v != v8 || ok != ok8 {
+			t.Errorf("[%s]: expected [%d, %t], got [%d, %t]", in, v8, ok8, v, ok)
+		}
+	}
+}
+
+func BenchmarkStrconvParseInt64Min(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseInt(deci64min, 10, 64)
+	}
+}
+
+func BenchmarkRushInt64Min(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseInt64(deci64min)
+	}
+}
+
+func BenchmarkStrconvParseInt64Mid(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseInt(deci64mid, 10, 64)
+	}
+}
+
+func BenchmarkRushInt64Mid(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseInt64(deci64mid)
+	}
+}
+
+func BenchmarkStrconvParseInt32Min(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseInt(deci32min, 10, 32)
+	}
+}
+
+func BenchmarkRushInt32Min(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseInt32(deci32min)
+	}
+}
+
+func BenchmarkStrconvParseInt16Min(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseInt(deci16min, 10, 16)
+	}
+}
+
+func BenchmarkRushInt16Min(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseInt16(deci16min)
+	}
+}
+
+func BenchmarkStrconvParseInt8Min(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseInt(deci8min, 10, 8)
+	}
+}
+
+func BenchmarkRushInt8Min(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseInt8(deci8min)
+	}
+}
@@ -9,8 +9,8 @@ package chars
 // ParseUint64 takes an unsigned integer encoded as base10 (decimal) and converts it
 // to an unsigned 64-bit integer.
 //
-// The max length of the string is 20 characters and the max value of the number is
-// maxUint64 (18446744073709551615).
+// The max length of the string is 20 characters (not counting leading zeros) and the max
+// value of the number is maxUint64 (18446744073709551615).
 // If either overflows, maxUint64 and false get returned.
 // If the string contains non-numeric ASCII characters, 0 and false get returned.
 func ParseUint64(s string) (uint64, bool) {
@@ -35,9 +35,15 @@ func ParseUint64(s string) (uint64, bool) {
 	// Overflow is only ever possible if we're at the max string length
 	// of a base10 encoded number, which is why the last pass is unrolled
 	// out of the multiplication loop and performed when an overflow is
-	// possible (ovf = 1).
+	// possible (ovf = 1). Leading zeros don't count towards that length,
+	// but only get skipped for inputs exceeding it, keeping them off the
+	// hot path.
 	if n >= uint64Digits-1 {
 		if n > uint64Digits-1 {
+			if s[0] == '0' {
+				return ParseUint64(skipZeros(s))
+			}
+
 			return uint64Max, false
 		}
 
@@ -50,7 +56,30 @@ func ParseUint64(s string) (uint64, bool) {
 	// 0.4ns from the access in the ovf condition further down.
 	_ = s[n-ovf]
 
-	for i := 0; i <= n-ovf; i++ {
+	// Long inputs (IDs, timestamps) get consumed 8 digits at a time first - or 16 at once
+	// where a vectorized kernel is available. At most 19 digits pass through here, so neither
+	// r*1e8 nor the SIMD path can overflow.
+	i := 0
+	if simdEnabled && n-ovf >= 15 {
+		v, ok := parseSixteenDigits(s)
+		if !ok {
+			return 0, false
+		}
+
+		r = v
+		i = 16
+	}
+
+	for ; n-ovf-i >= 7; i += 8 {
+		v, ok := parseEightDigits(loadEight(s[i:]))
+		if !ok {
+			return 0, false
+		}
+
+		r = r*1e8 + v
+	}
+
+	for ; i <= n-ovf; i++ {
 		b = s[i] - '0'
 		if b > 9 {
 			// Syntax error.
@@ -70,13 +99,13 @@ func ParseUint64(s string) (uint64, bool) {
 	// Technically n is always 19, but the 1.12 compiler would insert a bounds check.
 	b = s[n] - '0'
 
-	// Max    is 18446744073709551615, last digit can't be > 5 or ADD would overflow.
-	// Cutoff is 1844674407370955161 accordingly.
-	if r > uint64Cutoff || b > 5 {
-		if b > 9 {
-			return 0, false
-		}
+	if b > 9 {
+		return 0, false
+	}
 
+	// Max    is 18446744073709551615, if r is at the cutoff the last digit can't be > 5 or ADD would overflow.
+	// Cutoff is 1844674407370955161 accordingly.
+	if r > uint64Cutoff || r == uint64Cutoff && b > 5 {
 		return uint64Max, false
 	}
 
@@ -86,8 +115,8 @@ func ParseUint64(s string) (uint64, bool) {
 // ParseUint32 takes an unsigned integer encoded as base10 (decimal) and converts it
 // to an unsigned 32-bit integer.
 //
-// The max length of the string is 10 characters and the max value of the number is
-// uint32Max (4294967295).
+// The max length of the string is 10 characters (not counting leading zeros) and the max
+// value of the number is uint32Max (4294967295).
 // If either overflows, uint32Max and false get returned.
 // If the string contains non-numeric ASCII characters, 0 and false get returned.
 func ParseUint32(s string) (uint32, bool) {
@@ -109,6 +138,10 @@ func ParseUint32(s string) (uint32, bool) {
 
 	if n >= uint32Digits-1 {
 		if n > uint32Digits-1 {
+			if s[0] == '0' {
+				return ParseUint32(skipZeros(s))
+			}
+
 			return uint32Max, false
 		}
 
@@ -117,7 +150,19 @@ func ParseUint32(s string) (uint32, bool) {
 
 	_ = s[n-ovf]
 
-	for i := 0; i <= n-ovf; i++ {
+	// At most 9 digits pass through here, so r*1e8 can't overflow.
+	i := 0
+	if n-ovf >= 7 {
+		v, ok := parseEightDigits(loadEight(s))
+		if !ok {
+			return 0, false
+		}
+
+		r = uint32(v)
+		i = 8
+	}
+
+	for ; i <= n-ovf; i++ {
 		b = s[i] - '0'
 		if b > 9 {
 			return 0, false
@@ -132,13 +177,13 @@ func ParseUint32(s string) (uint32, bool) {
 
 	b = s[n] - '0'
 
-	// Max    is 4294967295, last digit can't be > 5 or ADD would overflow.
-	// Cutoff is 429496729 accordingly.
-	if r > uint32Cutoff || b > 5 {
-		if b > 9 {
-			return 0, false
-		}
+	if b > 9 {
+		return 0, false
+	}
 
+	// Max    is 4294967295, if r is at the cutoff the last digit can't be > 5 or ADD would overflow.
+	// Cutoff is 429496729 accordingly.
+	if r > uint32Cutoff || r == uint32Cutoff && b > 5 {
 		return uint32Max, false
 	}
 
@@ -148,8 +193,8 @@ func ParseUint32(s string) (uint32, bool) {
 // ParseUint16 takes an unsigned integer encoded as base10 (decimal) and converts it
 // to an unsigned 16-bit integer.
 //
-// The max length of the string is 5 characters and the max value of the number is
-// uint16Max (65535).
+// The max length of the string is 5 characters (not counting leading zeros) and the max
+// value of the number is uint16Max (65535).
 // If either overflows, uint16Max and false get returned.
 // If the string contains non-numeric ASCII characters, 0 and false get returned.
 func ParseUint16(s string) (uint16, bool) {
@@ -171,6 +216,10 @@ func ParseUint16(s string) (uint16, bool) {
 
 	if n >= uint16Digits-1 {
 		if n > uint16Digits-1 {
+			if s[0] == '0' {
+				return ParseUint16(skipZeros(s))
+			}
+
 			return uint16Max, false
 		}
 
@@ -194,13 +243,13 @@ func ParseUint16(s string) (uint16, bool) {
 
 	b = s[n] - '0'
 
-	// Max    is 65535, last digit can't be > 5 or ADD would overflow.
-	// Cutoff is 6553 accordingly.
-	if r > uint16Cutoff || b > 5 {
-		if b > 9 {
-			return 0, false
-		}
+	if b > 9 {
+		return 0, false
+	}
 
+	// Max    is 65535, if r is at the cutoff the last digit can't be > 5 or ADD would overflow.
+	// Cutoff is 6553 accordingly.
+	if r > uint16Cutoff || r == uint16Cutoff && b > 5 {
 		return uint16Max, false
 	}
 
@@ -210,8 +259,8 @@ func ParseUint16(s string) (uint16, bool) {
 // ParseUint8 takes an unsigned integer encoded as base10 (decimal) and converts it
 // to an unsigned 8-bit integer.
 //
-// The max length of the string is 3 characters and the max value of the number is
-// uint8Max (255).
+// The max length of the string is 3 characters (not counting leading zeros) and the max
+// value of the number is uint8Max (255).
 // If either overflows, uint8Max and false get returned.
 // If the string contains non-numeric ASCII characters, 0 and false get returned.
 func ParseUint8(s string) (uint8, bool) {
@@ -245,9 +294,9 @@ func ParseUint8(s string) (uint8, bool) {
 			return 100 + b*10 + c, true
 
 		case 2:
-			// Catches overflow since b*10 + c = x will never be > 200, but will roll over to 0
-			// when x > 55.
-			if r := 200 + b*10 + c; r > 200 {
+			// Catches overflow since 200 + b*10 + c never drops below 200 unless it rolls over,
+			// which it does once b*10 + c > 55.
+			if r := 200 + b*10 + c; r >= 200 {
 				return r, true
 			}
 			return uint8Max, false
@@ -263,12 +312,44 @@ func ParseUint8(s string) (uint8, bool) {
 	}
 
 	if len(s) > uint8Digits {
+		if s[0] == '0' {
+			return ParseUint8(skipZeros(s))
+		}
+
 		return uint8Max, false
 	}
 
 	return 0, false
 }
 
+// ParseUint64Bytes works like ParseUint64 but takes a byte slice, e.g. straight out of a read buffer.
+//
+// The input does not get converted (copied) to a string, so this never allocates.
+func ParseUint64Bytes(b []byte) (uint64, bool) {
+	return ParseUint64(bytesToString(b))
+}
+
+// ParseUint32Bytes works like ParseUint32 but takes a byte slice, e.g. straight out of a read buffer.
+//
+// The input does not get converted (copied) to a string, so this never allocates.
+func ParseUint32Bytes(b []byte) (uint32, bool) {
+	return ParseUint32(bytesToString(b))
+}
+
+// ParseUint16Bytes works like ParseUint16 but takes a byte slice, e.g. straight out of a read buffer.
+//
+// The input does not get converted (copied) to a string, so this never allocates.
+func ParseUint16Bytes(b []byte) (uint16, bool) {
+	return ParseUint16(bytesToString(b))
+}
+
+// ParseUint8Bytes works like ParseUint8 but takes a byte slice, e.g. straight out of a read buffer.
+//
+// The input does not get converted (copied) to a string, so this never allocates.
+func ParseUint8Bytes(b []byte) (uint8, bool) {
+	return ParseUint8(bytesToString(b))
+}
+
 // ParseDigit takes a single character representing a base10 encoded unsigned integer
 // and converts it to an unsigned 8-bit integer.
 //
@@ -283,3 +364,14 @@ func ParseDigit(b uint8) (uint8, bool) {
 	// Syntax error.
 	return 0, false
 }
+
+// skipZeros returns s without its leading zeros, but keeps the last character, so that a
+// string of only zeros becomes "0".
+func skipZeros(s string) string {
+	i := 0
+	for i < len(s)-1 && s[i] == '0' {
+		i++
+	}
+
+	return s[i:]
+}
@@ -15,6 +15,8 @@ const (
 	dec8max  = "255"
 	dec8mid  = "25"
 	decDigit = "9"
+	dec64ts  = "1582761600000"    // Millisecond timestamp.
+	dec64id  = "1234567890123456" // 16 digit ID.
 )
 
 func TestParseUint64(t *testing.T) {
@@ -28,9 +30,16 @@ func TestParseUint64(t *testing.T) {
 		{"min", "0", 0, true},
 		{"mid", dec64mid, 1844674407, true},
 		{"max", dec64max, uint64Max, true},
+		{"max-len", "10000000000000000009", 10000000000000000009, true},
 		{"overflow-len", "984467440737095516150", uint64Max, false},
 		{"overflow-num", "98446744073709551615", uint64Max, false},
+		{"leading-zeros", "000000000000000000000001", 1, true},
+		{"leading-zeros-max", "0000" + dec64max, uint64Max, true},
+		{"leading-zeros-all", "000000000000000000000000", 0, true},
+		{"leading-zeros-overflow", "0000" + "18446744073709551616", uint64Max, false},
+		{"leading-zeros-syntax", "0000" + "1000000000000000000d", 0, false},
 		{"syntax", "984467dddddd", 0, false},
+		{"syntax-last", "1000000000000000000d", 0, false},
 	} {
 		c := c
 		t.Run(c.name, func(t *testing.T) {
@@ -60,8 +69,12 @@ func TestParseUint32(t *testing.T) {
 		{"min", "0", 0, true},
 		{"mid", dec32mid, 429496, true},
 		{"max", dec32max, uint32Max, true},
+		{"max-len", "2147483647", 2147483647, true},
 		{"overflow-len", "42949672950", uint32Max, false},
 		{"overflow-num", "5294967295", uint32Max, false},
+		{"leading-zeros", "000000000001", 1, true},
+		{"leading-zeros-max", "00" + dec32max, uint32Max, true},
+		{"leading-zeros-overflow", "00" + "4294967296", uint32Max, false},
 		{"syntax", "4w9x9x7x95", 0, false},
 	} {
 		c := c
@@ -92,8 +105,12 @@ func TestParseUint16(t *testing.T) {
 		{"min", "0", 0, true},
 		{"mid", dec16mid, 655, true},
 		{"max", dec16max, uint16Max, true},
+		{"max-len", "32767", 32767, true},
 		{"overflow-len", "655355", uint16Max, false},
 		{"overflow-num", "99999", uint16Max, false},
+		{"leading-zeros", "0000001", 1, true},
+		{"leading-zeros-max", "00" + dec16max, uint16Max, true},
+		{"leading-zeros-overflow", "00" + "65536", uint16Max, false},
 		{"syntax", "6aaa5", 0, false},
 	} {
 		c := c
@@ -125,7 +142,14 @@ func TestParseUint8(t *testing.T) {
 		{"mid", dec8mid, 25, true},
 		{"max", dec8max, uint8Max, true},
 		{"overflow-len", "2555", uint8Max, false},
+		{"hundreds-two", "200", 200, true},
+		{"overflow-num-two", "256", uint8Max, false},
 		{"overflow-num", "300", uint8Max, false},
+		{"leading-zeros", "0001", 1, true},
+		{"leading-zeros-max", "000000" + dec8max, uint8Max, true},
+		{"leading-zeros-all", "0000", 0, true},
+		{"leading-zeros-overflow", "0256", uint8Max, false},
+		{"leading-zeros-syntax", "000x", 0, false},
 		{"syntax", "2a6", 0, false},
 	} {
 		c := c
@@ -145,6 +169,40 @@ func TestParseUint8(t *testing.T) {
 	}
 }
 
+func TestParseUintBytes(t *testing.T) {
+	for _, in := range []string{"", "0", decDigit, dec8max, dec16max, dec32max, dec64max, "984467dddddd"} {
+		b := []byte(in)
+
+		v64, ok64 := ParseUint64(in)
+		if v, ok := ParseUint64Bytes(b); v != v64 || ok != ok64 {
+			t.Errorf("[%s]: expected [%d, %t], got [%d, %t]", in, v64, ok64, v, ok)
+		}
+
+		v32, ok32 := ParseUint32(in)
+		if v, ok := ParseUint32Bytes(b); v != v32 || ok != ok32 {
+			t.Errorf("[%s]: expected [%d, %t], got [%d, %t]", in, v32, ok32, v, ok)
+		}
+
+		v16, ok16 := ParseUint16(in)
+		if v, ok := ParseUint16Bytes(b); v != v16 || ok != ok16 {
+			t.Errorf("[%s]: expected [%d, %t], got [%d, %t]", in, v16, ok16, v, ok)
+		}
+
+		v8, ok8 := ParseUint8(in)
+		if v, ok := ParseUint8Bytes(b); v != v8 || ok != ok8 {
+			t.Errorf("[%s]: expected [%d, %t], got [%d, %t]", in, v8, ok8, v, ok)
+		}
+	}
+}
+
+func TestParseUint64BytesAllocs(t *testing.T) {
+	b := []byte(dec64max)
+
+	if n := testing.AllocsPerRun(100, func() { _, _ = ParseUint64Bytes(b) }); n != 0 {
+		t.Errorf("expected [0] allocs, got [%f]", n)
+	}
+}
+
 func BenchmarkStrconvParse8Max(b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		_, _ = strconv.ParseUint(dec8max, 10, 8)
@@ -325,6 +383,42 @@ func BenchmarkRush64Max(b *testing.B) {
 	}
 }
 
+func BenchmarkStrconvParse64Timestamp(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseUint(dec64ts, 10, 64)
+	}
+}
+
+func BenchmarkBytewise64Timestamp(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = parseUint64Bytewise(dec64ts)
+	}
+}
+
+func BenchmarkRush64Timestamp(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseUint64(dec64ts)
+	}
+}
+
+func BenchmarkStrconvParse64ID(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseUint(dec64id, 10, 64)
+	}
+}
+
+func BenchmarkBytewise64ID(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = parseUint64Bytewise(dec64id)
+	}
+}
+
+func BenchmarkRush64ID(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseUint64(dec64id)
+	}
+}
+
 func BenchmarkStrconvParse64Mid(b *testing.B) {
 	for n := 0; n < b.N; n++ {
 		_, _ = strconv.ParseUint(dec64mid, 10, 64)
@@ -360,3 +454,10 @@ func BenchmarkRush64Digit(b *testing.B) {
 		_, _ = ParseUint64(decDigit)
 	}
 }
+
+func BenchmarkRush64MaxBytes(b *testing.B) {
+	by := []byte(dec64max)
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseUint64Bytes(by)
+	}
+}
@@ -0,0 +1,254 @@
+package chars
+
+import (
+	"errors"
+	"strconv"
+)
+
+// Error-returning variants of the parsers, for when the reason a parse failed matters.
+//
+// Those are implemented on top of the (value, bool) parsers - the fast path is the same and
+// only failed parses take a second, slower pass over the input to find out what went wrong.
+// Contrary to the (value, bool) parsers, syntax errors get reported before range errors here.
+// This deliberately differs from strconv as well, which reports whichever error it hits first
+// left to right, e.g. ErrRange for "99999999999999999999x" where ParseUint64E reports
+// ErrSyntax at offset 20 - an input that is invalid anywhere is never a mere range issue.
+
+var (
+	// ErrSyntax indicates that the input contains a character that is not valid for the target type.
+	// It is the same value as strconv.ErrSyntax.
+	ErrSyntax = strconv.ErrSyntax
+
+	// ErrRange indicates that the value is out of range for the target type.
+	// It is the same value as strconv.ErrRange.
+	ErrRange = strconv.ErrRange
+
+	// ErrEmpty indicates that the input is empty (or consists of only a sign).
+	// A NumError wrapping it also matches strconv.ErrSyntax, since strconv treats empty input
+	// as a syntax error.
+	ErrEmpty = errors.New("empty input")
+)
+
+// NumError records a failed conversion.
+//
+// Mirrors strconv.NumError, but additionally records the offset of the offending byte and the
+// width of the target type.
+type NumError struct {
+	Func    string // The failing function (ParseUint64, ParseInt32, ...).
+	Num     string // The input.
+	Offset  int    // Offset of the offending byte in Num - the first invalid or first overflowing digit.
+	BitSize int    // Width of the target type.
+	Err     error  // The reason the conversion failed (ErrEmpty, ErrSyntax, ErrRange).
+}
+
+func (e *NumError) Error() string {
+	return "chars." + e.Func + ": parsing " + strconv.Quote(e.Num) + ": " + e.Err.Error() +
+		" at offset " + strconv.Itoa(e.Offset)
+}
+
+// Unwrap returns the underlying sentinel error.
+func (e *NumError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error matches target. Besides the wrapped sentinel (via Unwrap),
+// ErrEmpty also matches strconv.ErrSyntax.
+func (e *NumError) Is(target error) bool {
+	return e.Err == ErrEmpty && target == strconv.ErrSyntax
+}
+
+// ParseUint64E works like ParseUint64 but reports failures as a *NumError.
+//
+// On ErrRange, uint64Max and the error get returned. Otherwise 0 and the error get returned.
+func ParseUint64E(s string) (uint64, error) {
+	if u, ok := ParseUint64(s); ok {
+		return u, nil
+	}
+
+	err := diagnose("ParseUint64", s, 64, 0, uint64Max)
+	if err.Err == ErrRange {
+		return uint64Max, err
+	}
+
+	return 0, err
+}
+
+// ParseUint32E works like ParseUint32 but reports failures as a *NumError.
+//
+// On ErrRange, uint32Max and the error get returned. Otherwise 0 and the error get returned.
+func ParseUint32E(s string) (uint32, error) {
+	if u, ok := ParseUint32(s); ok {
+		return u, nil
+	}
+
+	err := diagnose("ParseUint32", s, 32, 0, uint32Max)
+	if err.Err == ErrRange {
+		return uint32Max, err
+	}
+
+	return 0, err
+}
+
+// ParseUint16E works like ParseUint16 but reports failures as a *NumError.
+//
+// On ErrRange, uint16Max and the error get returned. Otherwise 0 and the error get returned.
+func ParseUint16E(s string) (uint16, error) {
+	if u, ok := ParseUint16(s); ok {
+		return u, nil
+	}
+
+	err := diagnose("ParseUint16", s, 16, 0, uint16Max)
+	if err.Err == ErrRange {
+		return uint16Max, err
+	}
+
+	return 0, err
+}
+
+// ParseUint8E works like ParseUint8 but reports failures as a *NumError.
+//
+// On ErrRange, uint8Max and the error get returned. Otherwise 0 and the error get returned.
+func ParseUint8E(s string) (uint8, error) {
+	if u, ok := ParseUint8(s); ok {
+		return u, nil
+	}
+
+	err := diagnose("ParseUint8", s, 8, 0, uint8Max)
+	if err.Err == ErrRange {
+		return uint8Max, err
+	}
+
+	return 0, err
+}
+
+// ParseInt64E works like ParseInt64 but reports failures as a *NumError.
+//
+// On ErrRange, int64Min or int64Max (depending on the sign) and the error get returned.
+// Otherwise 0 and the error get returned.
+func ParseInt64E(s string) (int64, error) {
+	i, ok := ParseInt64(s)
+	if ok {
+		return i, nil
+	}
+
+	err := diagnose("ParseInt64", s, 64, -int64Min, int64Max)
+	if err.Err == ErrRange {
+		return i, err
+	}
+
+	return 0, err
+}
+
+// ParseInt32E works like ParseInt32 but reports failures as a *NumError.
+//
+// On ErrRange, int32Min or int32Max (depending on the sign) and the error get returned.
+// Otherwise 0 and the error get returned.
+func ParseInt32E(s string) (int32, error) {
+	i, ok := ParseInt32(s)
+	if ok {
+		return i, nil
+	}
+
+	err := diagnose("ParseInt32", s, 32, -int32Min, int32Max)
+	if err.Err == ErrRange {
+		return i, err
+	}
+
+	return 0, err
+}
+
+// ParseInt16E works like ParseInt16 but reports failures as a *NumError.
+//
+// On ErrRange, int16Min or int16Max (depending on the sign) and the error get returned.
+// Otherwise 0 and the error get returned.
+func ParseInt16E(s string) (int16, error) {
+	i, ok := ParseInt16(s)
+	if ok {
+		return i, nil
+	}
+
+	err := diagnose("ParseInt16", s, 16, -int16Min, int16Max)
+	if err.Err == ErrRange {
+		return i, err
+	}
+
+	return 0, err
+}
+
+// ParseInt8E works like ParseInt8 but reports failures as a *NumError.
+//
+// On ErrRange, int8Min or int8Max (depending on the sign) and the error get returned.
+// Otherwise 0 and the error get returned.
+func ParseInt8E(s string) (int8, error) {
+	i, ok := ParseInt8(s)
+	if ok {
+		return i, nil
+	}
+
+	err := diagnose("ParseInt8", s, 8, -int8Min, int8Max)
+	if err.Err == ErrRange {
+		return i, err
+	}
+
+	return 0, err
+}
+
+// diagnose finds out why s failed to parse as an integer in the range of -negMax to posMax.
+// A negMax of 0 denotes an unsigned target type, which does not accept a sign.
+//
+// Only ever called on the slow path, after a parser has already rejected s.
+func diagnose(fn, s string, bitSize int, negMax, posMax uint64) *NumError {
+	err := &NumError{
+		Func:    fn,
+		Num:     s,
+		BitSize: bitSize,
+		Err:     ErrEmpty,
+	}
+
+	var (
+		i   int
+		max = posMax
+	)
+
+	if negMax != 0 && len(s) > 0 {
+		switch s[0] {
+		case '-':
+			max = negMax
+			i = 1
+		case '+':
+			i = 1
+		}
+	}
+
+	if i == len(s) {
+		err.Offset = i
+		return err
+	}
+
+	// Syntax errors take precedence over range errors, so the whole input gets
+	// validated first.
+	for j := i; j < len(s); j++ {
+		if s[j]-'0' > 9 {
+			err.Offset = j
+			err.Err = ErrSyntax
+			return err
+		}
+	}
+
+	var r uint64
+	for ; i < len(s); i++ {
+		d := uint64(s[i] - '0')
+		if r > (max-d)/10 {
+			break
+		}
+
+		r = r*10 + d
+	}
+
+	// Leading zeros don't count towards the max length in the typed parsers, so anything
+	// reaching this point does overflow - at the first digit that can't be accumulated.
+	err.Offset = i
+	err.Err = ErrRange
+
+	return err
+}
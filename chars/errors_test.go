@@ -0,0 +1,167 @@
+package chars
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestParseUint64E(t *testing.T) {
+	for _, c := range []struct {
+		name           string
+		in             string
+		expected       uint64
+		expectedErr    error
+		expectedOffset int
+	}{
+		{"empty", "", 0, ErrEmpty, 0},
+		{"min", "0", 0, nil, 0},
+		{"max", dec64max, uint64Max, nil, 0},
+		{"overflow-num", "18446744073709551616", uint64Max, ErrRange, 19},
+		{"overflow-len", "184467440737095516150", uint64Max, ErrRange, 20},
+		{"leading-zeros", "0000" + dec64max, uint64Max, nil, 0},
+		{"leading-zeros-overflow", "0000" + "18446744073709551616", uint64Max, ErrRange, 23},
+		{"syntax", "1844x", 0, ErrSyntax, 4},
+		{"syntax-before-range", "184467440737095516150x", 0, ErrSyntax, 21},
+		{"sign", "+1", 0, ErrSyntax, 0},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := ParseUint64E(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if c.expectedErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got [%v]", err)
+				}
+				return
+			}
+
+			var ne *NumError
+			if !errors.As(err, &ne) {
+				t.Fatalf("expected a *NumError, got [%v]", err)
+			}
+
+			if !errors.Is(err, c.expectedErr) {
+				t.Errorf("expected [%v], got [%v]", c.expectedErr, ne.Err)
+			}
+
+			if ne.Offset != c.expectedOffset {
+				t.Errorf("expected offset [%d], got [%d]", c.expectedOffset, ne.Offset)
+			}
+
+			if ne.BitSize != 64 || ne.Num != c.in || ne.Func != "ParseUint64" {
+				t.Errorf("unexpected error fields [%+v]", ne)
+			}
+		})
+	}
+}
+
+func TestParseUintE(t *testing.T) {
+	if v, err := ParseUint32E("4294967296"); v != uint32Max || !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("expected [%d, %v], got [%d, %v]", uint64(uint32Max), ErrRange, v, err)
+	}
+
+	if v, err := ParseUint16E("6x"); v != 0 || !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected [0, %v], got [%d, %v]", ErrSyntax, v, err)
+	}
+
+	if v, err := ParseUint8E("256"); v != uint8Max || !errors.Is(err, strconv.ErrRange) {
+		t.Errorf("expected [%d, %v], got [%d, %v]", uint8Max, ErrRange, v, err)
+	}
+
+	if v, err := ParseUint8E(dec8max); v != uint8Max || err != nil {
+		t.Errorf("expected [%d, nil], got [%d, %v]", uint8Max, v, err)
+	}
+
+	if v, err := ParseUint8E("200"); v != 200 || err != nil {
+		t.Errorf("expected [200, nil], got [%d, %v]", v, err)
+	}
+}
+
+func TestParseIntE(t *testing.T) {
+	for _, c := range []struct {
+		name           string
+		in             string
+		expected       int64
+		expectedErr    error
+		expectedOffset int
+	}{
+		{"empty", "", 0, ErrEmpty, 0},
+		{"sign-only", "-", 0, ErrEmpty, 1},
+		{"min", deci64min, int64Min, nil, 0},
+		{"overflow-min", "-9223372036854775809", int64Min, ErrRange, 19},
+		{"overflow-max", "+9223372036854775808", int64Max, ErrRange, 19},
+		{"syntax", "-92x", 0, ErrSyntax, 3},
+		{"syntax-sign", "-+1", 0, ErrSyntax, 1},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := ParseInt64E(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if c.expectedErr == nil {
+				if err != nil {
+					t.Errorf("expected no error, got [%v]", err)
+				}
+				return
+			}
+
+			var ne *NumError
+			if !errors.As(err, &ne) {
+				t.Fatalf("expected a *NumError, got [%v]", err)
+			}
+
+			if !errors.Is(err, c.expectedErr) {
+				t.Errorf("expected [%v], got [%v]", c.expectedErr, ne.Err)
+			}
+
+			if ne.Offset != c.expectedOffset {
+				t.Errorf("expected offset [%d], got [%d]", c.expectedOffset, ne.Offset)
+			}
+		})
+	}
+
+	if v, err := ParseInt8E("-129"); v != int8Min || !errors.Is(err, ErrRange) {
+		t.Errorf("expected [%d, %v], got [%d, %v]", int8Min, ErrRange, v, err)
+	}
+
+	if v, err := ParseInt16E("32768"); v != int16Max || !errors.Is(err, ErrRange) {
+		t.Errorf("expected [%d, %v], got [%d, %v]", int16Max, ErrRange, v, err)
+	}
+
+	if v, err := ParseInt32E(deci32min); v != int32Min || err != nil {
+		t.Errorf("expected [%d, nil], got [%d, %v]", int32Min, v, err)
+	}
+}
+
+func TestNumErrorIs(t *testing.T) {
+	_, err := ParseUint64E("")
+
+	if !errors.Is(err, ErrEmpty) {
+		t.Errorf("expected [%v] to match ErrEmpty", err)
+	}
+
+	if !errors.Is(err, strconv.ErrSyntax) {
+		t.Errorf("expected [%v] to match strconv.ErrSyntax", err)
+	}
+
+	if errors.Is(err, strconv.ErrRange) {
+		t.Errorf("expected [%v] to not match strconv.ErrRange", err)
+	}
+
+	expected := `chars.ParseUint64: parsing "": empty input at offset 0`
+	if err.Error() != expected {
+		t.Errorf("expected [%s], got [%s]", expected, err.Error())
+	}
+}
@@ -0,0 +1,161 @@
+package chars
+
+// Fixed-point formatting of scaled integers, e.g. 123456 cents with a scale of 2 as 1234.56.
+//
+// The digits get produced by the same LUT-based routine as CopyUint64 and the decimal point
+// gets inserted while copying them out, with zeros filled in where the scale exceeds the
+// number of digits (0.05).
+
+// FixedFormat describes how the fraction of a scaled integer gets laid out.
+type FixedFormat struct {
+	// TrimZeros removes trailing zeros from the fraction, along with the decimal point
+	// if no fraction digits remain (1234.50 becomes 1234.5, 1234.00 becomes 1234).
+	TrimZeros bool
+
+	// MinFrac is the minimum number of fraction digits. Applies after trimming and pads with
+	// zeros past the scale if needed (1234.5 with a MinFrac of 2 becomes 1234.50).
+	MinFrac int
+}
+
+// CopyUfixed64 copies the base10 representation of u scaled down by 10^scale, i.e. with the
+// decimal point before the last scale digits, laid out according to f, into dst up to
+// len(dst), discarding all overflowing bytes. A negative scale appends -scale zeros.
+//
+// Returns the number of bytes copied to dst.
+func CopyUfixed64(dst []byte, u uint64, scale int, f FixedFormat) int {
+	var b [uint64Digits]byte
+	return copyFixed(dst, "", b[formatUint64(b[:], u):], scale, f)
+}
+
+// CopyFixed64 copies the base10 representation of i scaled down by 10^scale, i.e. with the
+// decimal point before the last scale digits, laid out according to f, into dst up to
+// len(dst), discarding all overflowing bytes. Negative values get prefixed with '-'.
+// A negative scale appends -scale zeros.
+//
+// Returns the number of bytes copied to dst.
+func CopyFixed64(dst []byte, i int64, scale int, f FixedFormat) int {
+	var b [uint64Digits]byte
+	if i >= 0 {
+		return copyFixed(dst, "", b[formatUint64(b[:], uint64(i)):], scale, f)
+	}
+
+	return copyFixed(dst, "-", b[formatUint64(b[:], uint64(-i)):], scale, f)
+}
+
+// AppendUfixed64 appends the base10 representation of u scaled down by 10^scale, laid out
+// according to f, to dst and returns the extended buffer, growing it if necessary.
+func AppendUfixed64(dst []byte, u uint64, scale int, f FixedFormat) []byte {
+	var b [uint64Digits]byte
+	return appendFixed(dst, "", b[formatUint64(b[:], u):], scale, f)
+}
+
+// AppendFixed64 appends the base10 representation of i scaled down by 10^scale, laid out
+// according to f, to dst and returns the extended buffer, growing it if necessary. Negative
+// values get prefixed with '-'.
+func AppendFixed64(dst []byte, i int64, scale int, f FixedFormat) []byte {
+	var b [uint64Digits]byte
+	if i >= 0 {
+		return appendFixed(dst, "", b[formatUint64(b[:], uint64(i)):], scale, f)
+	}
+
+	return appendFixed(dst, "-", b[formatUint64(b[:], uint64(-i)):], scale, f)
+}
+
+// fixedParts is the layout of digits with a decimal point: the integer digits (or 0) and
+// zeros, followed by the decimal point, lead zeros, the fraction digits and pad zeros, if
+// there is a fraction.
+type fixedParts struct {
+	integer string
+	zeros   int
+	lead    int
+	frac    []byte
+	pad     int
+}
+
+func splitFixed(digits []byte, scale int, f FixedFormat) (p fixedParts) {
+	switch n := len(digits) - scale; {
+	case scale <= 0:
+		// Scaled up, so there is no fraction. Zero stays a single 0.
+		p.integer, digits = bytesToString(digits), nil
+		if p.integer != "0" {
+			p.zeros = -scale
+		}
+	case n > 0:
+		p.integer, digits = bytesToString(digits[:n]), digits[n:]
+	default:
+		p.integer, p.lead = "0", -n
+	}
+
+	if f.TrimZeros {
+		n := len(digits)
+		for n > 0 && digits[n-1] == '0' {
+			n--
+		}
+
+		if digits = digits[:n]; n == 0 {
+			p.lead = 0
+		}
+	}
+
+	p.frac = digits
+	if n := p.lead + len(digits); n < f.MinFrac {
+		p.pad = f.MinFrac - n
+	}
+
+	return p
+}
+
+// len returns the number of bytes the layout takes up, excluding the sign.
+func (p *fixedParts) len() int {
+	n := len(p.integer) + p.zeros
+	if m := p.lead + len(p.frac) + p.pad; m > 0 {
+		n += 1 + m
+	}
+
+	return n
+}
+
+func appendFixed(dst []byte, sign string, digits []byte, scale int, f FixedFormat) []byte {
+	p := splitFixed(digits, scale, f)
+
+	n := len(sign) + p.len()
+	dst = grow(dst, n)
+	l := len(dst)
+
+	return dst[:l+p.copyTo(dst[l:l+n], sign)]
+}
+
+func copyFixed(dst []byte, sign string, digits []byte, scale int, f FixedFormat) int {
+	p := splitFixed(digits, scale, f)
+	return p.copyTo(dst, sign)
+}
+
+// copyTo copies the sign followed by the laid out digits into dst up to len(dst).
+func (p *fixedParts) copyTo(dst []byte, sign string) int {
+	n := copy(dst, sign)
+	n += copy(dst[n:], p.integer)
+	n += copyZeros(dst[n:], p.zeros)
+
+	if p.lead+len(p.frac)+p.pad == 0 {
+		return n
+	}
+
+	n += copy(dst[n:], ".")
+	n += copyZeros(dst[n:], p.lead)
+	n += copy(dst[n:], p.frac)
+
+	return n + copyZeros(dst[n:], p.pad)
+}
+
+// copyZeros fills dst with up to n '0' bytes and returns the number of bytes written.
+func copyZeros(dst []byte, n int) int {
+	if n > len(dst) {
+		n = len(dst)
+	}
+
+	for i := range dst[:n] {
+		dst[i] = '0'
+	}
+
+	return n
+}
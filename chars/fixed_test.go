@@ -0,0 +1,103 @@
+package chars
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestCopyFixed64(t *testing.T) {
+	var (
+		plain = FixedFormat{}
+		trim  = FixedFormat{TrimZeros: true}
+		min2  = FixedFormat{TrimZeros: true, MinFrac: 2}
+		pad4  = FixedFormat{MinFrac: 4}
+	)
+
+	for _, c := range []struct {
+		name     string
+		in       int64
+		scale    int
+		f        FixedFormat
+		expected string
+	}{
+		{"cents", 123456, 2, plain, "1234.56"},
+		{"neg-cents", -123456, 2, plain, "-1234.56"},
+		{"below-one", 5, 2, plain, "0.05"},
+		{"neg-below-one", -5, 3, plain, "-0.005"},
+		{"zero", 0, 2, plain, "0.00"},
+		{"scale-zero", 1234, 0, plain, "1234"},
+		{"scale-neg", 1234, -3, plain, "1234000"},
+		{"scale-neg-zero", 0, -3, plain, "0"},
+		{"scale-large", 1, 25, plain, "0.0000000000000000000000001"},
+		{"trim", 123450, 3, trim, "123.45"},
+		{"trim-all", 123000, 3, trim, "123"},
+		{"trim-zero", 0, 3, trim, "0"},
+		{"trim-lead", 100, 5, trim, "0.001"},
+		{"trim-min", 123000, 3, min2, "123.00"},
+		{"trim-min-partial", 123400, 3, min2, "123.40"},
+		{"trim-min-keep", 123456, 3, min2, "123.456"},
+		{"pad", 12345, 2, pad4, "123.4500"},
+		{"pad-scale-zero", 12, 0, pad4, "12.0000"},
+		{"min", int64Min, 18, plain, "-9.223372036854775808"},
+		{"max", int64Max, 19, plain, "0.9223372036854775807"},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var dst [32]byte
+			if actual := dst[:CopyFixed64(dst[:], c.in, c.scale, c.f)]; string(actual) != c.expected {
+				t.Errorf("expected [%s], got [%s]", c.expected, actual)
+			}
+
+			if actual := AppendFixed64([]byte("x"), c.in, c.scale, c.f); string(actual) != "x"+c.expected {
+				t.Errorf("expected [x%s], got [%s]", c.expected, actual)
+			}
+
+			if c.in < 0 {
+				return
+			}
+
+			if actual := dst[:CopyUfixed64(dst[:], uint64(c.in), c.scale, c.f)]; string(actual) != c.expected {
+				t.Errorf("expected [%s], got [%s]", c.expected, actual)
+			}
+
+			if actual := AppendUfixed64(nil, uint64(c.in), c.scale, c.f); string(actual) != c.expected {
+				t.Errorf("expected [%s], got [%s]", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCopyUfixed64Truncated(t *testing.T) {
+	for _, c := range []struct {
+		in       uint64
+		scale    int
+		size     int
+		expected string
+	}{
+		{123456, 2, 5, "1234."},
+		{123456, 2, 3, "123"},
+		{5, 4, 4, "0.00"},
+		{uint64Max, 2, 0, ""},
+	} {
+		dst := make([]byte, c.size)
+		if actual := dst[:CopyUfixed64(dst, c.in, c.scale, FixedFormat{})]; string(actual) != c.expected {
+			t.Errorf("[%d]: expected [%s], got [%s]", c.in, c.expected, actual)
+		}
+	}
+}
+
+func BenchmarkStrconvFormatFixed64(b *testing.B) {
+	dst := make([]byte, 0, 32)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendFloat(dst, float64(123456)/100, 'f', 2, 64)
+	}
+}
+
+func BenchmarkRushFormatFixed64(b *testing.B) {
+	dst := make([]byte, 0, 32)
+	for n := 0; n < b.N; n++ {
+		_ = AppendFixed64(dst, 123456, 2, FixedFormat{})
+	}
+}
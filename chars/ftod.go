@@ -0,0 +1,383 @@
+package chars
+
+import (
+	"math"
+	"math/bits"
+)
+
+// Float-to-decimal-string conversions.
+//
+// The shortest decimal that rounds back to the same float64 gets computed with Giulietti's
+// Schubfach algorithm (as used by Java's Double.toString): three 64x128-bit multiplications
+// against a precomputed approximation of a power of 10 and a few comparisons, without any
+// loops or bignum fallback. The resulting digits get produced by the same LUT-based routine
+// as CopyUint64 and then laid out according to the requested FloatFormat.
+
+// FloatFormat selects the layout of the shortest decimal representation of a float.
+type FloatFormat uint8
+
+const (
+	// FloatDecimal lays out the digits without exponent, e.g. 0.000123 or 1230000.
+	// Same output as strconv.FormatFloat(f, 'f', -1, 64).
+	FloatDecimal FloatFormat = iota
+
+	// FloatExponent lays out the digits in scientific notation with an at least two digit
+	// exponent, e.g. 1.23e-04 or 1.23e+06.
+	// Same output as strconv.FormatFloat(f, 'e', -1, 64).
+	FloatExponent
+
+	// FloatJS lays out the digits like ECMAScript's Number.prototype.toString (and thus
+	// JSON.stringify) does: without exponent for 1e-6 <= |f| < 1e21 and in scientific
+	// notation with an unpadded exponent otherwise, e.g. 1e-7 or 1e+21. Negative zero
+	// becomes 0 and NaN and the infinities become NaN, Infinity and -Infinity, which are
+	// not valid JSON - callers producing JSON need to reject them upfront.
+	FloatJS
+)
+
+const (
+	// Exponent of the ulp of subnormals (and of the smallest normal binade).
+	float64MinExp2 = 1 - float64Bias - float64MantBits
+
+	// Max length of a laid out float64, e.g. -0.(307 zeros)22250738585072014.
+	floatMaxLen = 327
+)
+
+// CopyFloat64 copies the shortest base10 representation of f that parses back to exactly f,
+// laid out according to format, into dst up to len(dst), discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+func CopyFloat64(dst []byte, f float64, format FloatFormat) int {
+	if s := specialFloat64(f, format); s != "" {
+		return copy(dst, s)
+	}
+
+	var d floatDigits
+	d.set(f, format)
+
+	return d.copyTo(dst, format)
+}
+
+// AppendFloat64 appends the shortest base10 representation of f that parses back to exactly
+// f, laid out according to format, to dst and returns the extended buffer, growing it if
+// necessary.
+//
+// Works like strconv.AppendFloat(dst, f, 'f', -1, 64) for FloatDecimal and like
+// strconv.AppendFloat(dst, f, 'e', -1, 64) for FloatExponent.
+func AppendFloat64(dst []byte, f float64, format FloatFormat) []byte {
+	if s := specialFloat64(f, format); s != "" {
+		return append(dst, s...)
+	}
+
+	var d floatDigits
+	d.set(f, format)
+
+	n := d.len(format)
+	dst = grow(dst, n)
+	l := len(dst)
+
+	return dst[:l+d.copyTo(dst[l:l+n], format)]
+}
+
+// specialFloat64 returns the representation of NaN and the infinities, or "" for all
+// other values.
+func specialFloat64(f float64, format FloatFormat) string {
+	switch {
+	case f != f:
+		return "NaN"
+	case f > math.MaxFloat64:
+		if format == FloatJS {
+			return "Infinity"
+		}
+
+		return "+Inf"
+	case f < -math.MaxFloat64:
+		if format == FloatJS {
+			return "-Infinity"
+		}
+
+		return "-Inf"
+	}
+
+	return ""
+}
+
+// floatDigits is the shortest decimal representation of a finite float64, i.e. the nd digits
+// of m with the decimal point after the first dp of them (or -dp zeros in front of them).
+type floatDigits struct {
+	m   uint64
+	nd  int
+	dp  int
+	neg bool
+}
+
+func (d *floatDigits) set(f float64, format FloatFormat) {
+	// ECMAScript formats negative zero without its sign.
+	d.neg = math.Signbit(f) && (f != 0 || format != FloatJS)
+
+	m, e := shortestFloat64(math.Abs(f))
+	d.m, d.nd = m, DecimalLen64(m)
+	d.dp = d.nd + e
+}
+
+func (d *floatDigits) exponent(format FloatFormat) bool {
+	if format == FloatJS {
+		return d.dp <= -6 || d.dp > 21
+	}
+
+	return format == FloatExponent
+}
+
+// len returns the number of bytes the laid out digits take up.
+func (d *floatDigits) len(format FloatFormat) int {
+	var n int
+	if d.neg {
+		n = 1
+	}
+
+	if d.exponent(format) {
+		if d.nd > 1 {
+			n++
+		}
+
+		return n + d.nd + floatExpLen(d.dp-1, format != FloatJS)
+	}
+
+	switch {
+	case d.dp <= 0:
+		return n + 2 - d.dp + d.nd
+	case d.dp >= d.nd:
+		return n + d.dp
+	}
+
+	return n + d.nd + 1
+}
+
+// copyTo copies the laid out digits into dst up to len(dst).
+//
+// Returns the number of bytes copied to dst.
+func (d *floatDigits) copyTo(dst []byte, format FloatFormat) int {
+	n := d.len(format)
+	if n <= len(dst) {
+		d.put(dst[:n], format)
+		return n
+	}
+
+	var b [floatMaxLen]byte
+	d.put(b[:n], format)
+
+	return copy(dst, b[:n])
+}
+
+// put writes the laid out digits into dst, which must be exactly d.len(format) bytes long.
+// The digits get formatted straight into place, with the leading ones shifted to the left
+// afterwards to make room for the decimal point.
+func (d *floatDigits) put(dst []byte, format FloatFormat) {
+	var i int
+	if d.neg {
+		dst[0] = '-'
+		i = 1
+	}
+
+	if d.exponent(format) {
+		end := i + 1
+		if d.nd == 1 {
+			dst[i] = '0' + byte(d.m)
+		} else {
+			end += d.nd
+			formatUint64(dst[i+1:end], d.m)
+			dst[i], dst[i+1] = dst[i+1], '.'
+		}
+
+		formatFloatExp(dst[end:], d.dp-1)
+		return
+	}
+
+	switch {
+	case d.dp <= 0:
+		dst[i], dst[i+1] = '0', '.'
+		for j := i + 2; j < i+2-d.dp; j++ {
+			dst[j] = '0'
+		}
+
+		formatUint64(dst, d.m)
+	case d.dp >= d.nd:
+		formatUint64(dst[:i+d.nd], d.m)
+		for j := i + d.nd; j < len(dst); j++ {
+			dst[j] = '0'
+		}
+	default:
+		formatUint64(dst, d.m)
+		for j := i; j < i+d.dp; j++ {
+			dst[j] = dst[j+1]
+		}
+
+		dst[i+d.dp] = '.'
+	}
+}
+
+// floatExpLen returns the length of the exponent suffix, e.g. 4 for e+07 with pad and 3 for
+// e+7 without.
+func floatExpLen(exp int, pad bool) int {
+	switch {
+	case exp <= -100 || exp >= 100:
+		return 5
+	case exp <= -10 || exp >= 10 || pad:
+		return 4
+	}
+
+	return 3
+}
+
+// formatFloatExp writes the exponent suffix into b, which must be exactly as long as
+// returned by floatExpLen.
+func formatFloatExp(b []byte, exp int) {
+	b[0], b[1] = 'e', '+'
+	if exp < 0 {
+		b[1] = '-'
+		exp = -exp
+	}
+
+	switch len(b) {
+	case 5:
+		b[2] = '0' + byte(exp/100)
+		exp = exp % 100 * 2
+		b[3], b[4] = smalls[exp], smalls[exp+1]
+	case 4:
+		exp *= 2
+		b[2], b[3] = smalls[exp], smalls[exp+1]
+	default:
+		b[2] = '0' + byte(exp)
+	}
+}
+
+// shortestFloat64 returns the shortest decimal m * 10^e that rounds to f, which must be
+// finite and not negative, with all trailing zeros removed from m. Of two equally short
+// candidates, the one closer to f wins, with ties going to the even one.
+func shortestFloat64(f float64) (m uint64, e int) {
+	b := math.Float64bits(f)
+	t := b & (1<<float64MantBits - 1)
+	bq := int(b >> float64MantBits)
+
+	switch {
+	case bq == 0 && t == 0:
+		return 0, 0
+	case bq == 0:
+		// Subnormal. Java scales the tiniest ones (t < 3) up by 10 to always get at least two
+		// digits (4.9E-324), which is not what's wanted here (5e-324).
+		m, e = schubfach(float64MinExp2, t)
+	default:
+		c := 1<<float64MantBits | t
+		q := bq - float64Bias - float64MantBits
+
+		// Integers below 2^53 are exactly representable, so their digits are the shortest.
+		if q < 0 && q > -float64MantBits-1 && c>>-q<<-q == c {
+			m, e = c>>-q, 0
+		} else {
+			m, e = schubfach(q, c)
+		}
+	}
+
+	for m%10 == 0 {
+		m /= 10
+		e++
+	}
+
+	return m, e
+}
+
+// schubfach returns the decimal m * 10^e closest to c * 2^q with the fewest digits that
+// still rounds to c * 2^q.
+//
+// Follows figure 7 and the efficient computations of section 9 of Raffaello Giulietti's
+// "The Schubfach way to render doubles" (2020). m may have trailing zeros.
+func schubfach(q int, c uint64) (uint64, int) {
+	var (
+		out = c & 1
+		cb  = c << 2
+		cbr = cb + 2
+		cbl uint64
+		k   int
+	)
+
+	if c != 1<<float64MantBits || q == float64MinExp2 {
+		// Regular spacing: the floats below and above c are equally far away.
+		cbl = cb - 2
+		k = floorLog10Pow2(q)
+	} else {
+		// Irregular spacing at the lower end of a binade: the float below is closer.
+		cbl = cb - 1
+		k = floorLog10ThreeQuartersPow2(q)
+	}
+
+	h := q + floorLog2Pow10(-k) + 2
+	g := &schubfachG[k-schubfachMinK]
+
+	vb := schubfachRop(g[0], g[1], cb<<h)
+	vbl := schubfachRop(g[0], g[1], cbl<<h)
+	vbr := schubfachRop(g[0], g[1], cbr<<h)
+
+	// Java only considers sp10 for s >= 100, because it always renders at least two digits.
+	s := vb >> 2
+	if s >= 10 {
+		// sp10 = s / 10 * 10, one digit less than s. Prefer it if exactly one of sp10 and
+		// sp10+10 lies within the rounding interval.
+		hi, _ := bits.Mul64(s, 115292150460684698<<4)
+		sp10 := 10 * hi
+		tp10 := sp10 + 10
+
+		upin := vbl+out <= sp10<<2
+		wpin := tp10<<2+out <= vbr
+		if upin != wpin {
+			if upin {
+				return sp10, k
+			}
+
+			return tp10, k
+		}
+	}
+
+	t := s + 1
+	uin := vbl+out <= s<<2
+	win := t<<2+out <= vbr
+	if uin != win {
+		if uin {
+			return s, k
+		}
+
+		return t, k
+	}
+
+	// Both s and t round to c * 2^q: pick the closer one, or the even one on a tie.
+	cmp := int64(vb - (s+t)<<1)
+	if cmp < 0 || cmp == 0 && s&1 == 0 {
+		return s, k
+	}
+
+	return t, k
+}
+
+// schubfachRop returns the product of g = g1*2^63 + g0 and cp, divided by 2^127 and rounded
+// to odd.
+func schubfachRop(g1, g0, cp uint64) uint64 {
+	x1, _ := bits.Mul64(g0, cp)
+	y1, y0 := bits.Mul64(g1, cp)
+	z := y0>>1 + x1
+	vbp := y1 + z>>63
+
+	return vbp | (z&(1<<63-1)+(1<<63-1))>>63
+}
+
+// floorLog10Pow2 returns floor(log10(2^e)), exact for all exponents of float64.
+func floorLog10Pow2(e int) int {
+	return int(int64(e) * 661971961083 >> 41)
+}
+
+// floorLog10ThreeQuartersPow2 returns floor(log10(3/4 * 2^e)), exact for all exponents of float64.
+func floorLog10ThreeQuartersPow2(e int) int {
+	return int((int64(e)*661971961083 - 274743187321) >> 41)
+}
+
+// floorLog2Pow10 returns floor(log2(10^e)), exact for all exponents of float64.
+func floorLog2Pow10(e int) int {
+	return int(int64(e) * 913124641741 >> 38)
+}
@@ -0,0 +1,152 @@
+package chars
+
+import (
+	"math"
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestCopyFloat64(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		in       float64
+		decimal  string
+		exponent string
+		js       string
+	}{
+		{"zero", 0, "0", "0e+00", "0"},
+		{"neg-zero", math.Copysign(0, -1), "-0", "-0e+00", "0"},
+		{"one", 1, "1", "1e+00", "1"},
+		{"int", 1234567, "1234567", "1.234567e+06", "1234567"},
+		{"neg", -0.5, "-0.5", "-5e-01", "-0.5"},
+		{"pi", math.Pi, "3.141592653589793", "3.141592653589793e+00", "3.141592653589793"},
+		{"tenth", 0.1, "0.1", "1e-01", "0.1"},
+		{"sum", 0.30000000000000004, "0.30000000000000004", "3.0000000000000004e-01", "0.30000000000000004"},
+		{"micro", 1e-6, "0.000001", "1e-06", "0.000001"},
+		{"js-exp-min", 1.5e-7, "0.00000015", "1.5e-07", "1.5e-7"},
+		{"js-exp-below-micro", 1e-7, "0.0000001", "1e-07", "1e-7"},
+		{"js-plain-max", 1e20, "100000000000000000000", "1e+20", "100000000000000000000"},
+		{"js-exp-max", 1e21, "1000000000000000000000", "1e+21", "1e+21"},
+		{"2^53", 1 << 53, "9007199254740992", "9.007199254740992e+15", "9007199254740992"},
+		{"large", 123456789012345680, "123456789012345680", "1.2345678901234568e+17", "123456789012345680"},
+		{"avogadro", 6.02214076e23, "602214076000000000000000", "6.02214076e+23", "6.02214076e+23"},
+		{"max", math.MaxFloat64, "179769313486231570" + zeros(291), "1.7976931348623157e+308", "1.7976931348623157e+308"},
+		{"min-normal", 0x1p-1022, "0." + zeros(307) + "22250738585072014", "2.2250738585072014e-308", "2.2250738585072014e-308"},
+		{"min-subnormal", math.SmallestNonzeroFloat64, "0." + zeros(323) + "5", "5e-324", "5e-324"},
+		{"tiny-subnormal", 2 * math.SmallestNonzeroFloat64, "0." + zeros(322) + "1", "1e-323", "1e-323"},
+		{"irregular", 0x1p-1000, "0." + zeros(301) + "9332636185032189", "9.332636185032189e-302", "9.332636185032189e-302"},
+		{"nan", math.NaN(), "NaN", "NaN", "NaN"},
+		{"inf", math.Inf(1), "+Inf", "+Inf", "Infinity"},
+		{"neg-inf", math.Inf(-1), "-Inf", "-Inf", "-Infinity"},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			for _, f := range []struct {
+				format   FloatFormat
+				expected string
+			}{
+				{FloatDecimal, c.decimal},
+				{FloatExponent, c.exponent},
+				{FloatJS, c.js},
+			} {
+				var dst [400]byte
+				if actual := dst[:CopyFloat64(dst[:], c.in, f.format)]; string(actual) != f.expected {
+					t.Errorf("[%d]: expected [%s], got [%s]", f.format, f.expected, actual)
+				}
+
+				if actual := AppendFloat64([]byte("x"), c.in, f.format); string(actual) != "x"+f.expected {
+					t.Errorf("[%d]: expected [x%s], got [%s]", f.format, f.expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func zeros(n int) string {
+	return strings.Repeat("0", n)
+}
+
+func TestCopyFloat64Truncated(t *testing.T) {
+	for _, c := range []struct {
+		in       float64
+		format   FloatFormat
+		size     int
+		expected string
+	}{
+		{-1.5e-7, FloatExponent, 4, "-1.5"},
+		{-1.5e-7, FloatExponent, 6, "-1.5e-"},
+		{1.5e-7, FloatDecimal, 4, "0.00"},
+		{1e21, FloatDecimal, 3, "100"},
+		{123.456, FloatJS, 4, "123."},
+		{math.Inf(-1), FloatJS, 4, "-Inf"},
+	} {
+		dst := make([]byte, c.size)
+		if actual := dst[:CopyFloat64(dst, c.in, c.format)]; string(actual) != c.expected {
+			t.Errorf("[%v]: expected [%s], got [%s]", c.in, c.expected, actual)
+		}
+	}
+}
+
+// TestFloat64Random checks the output against strconv (which uses Ryu) for random bit
+// patterns as well as for values with few significant digits.
+func TestFloat64Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(3))
+
+	var dst [400]byte
+	for i := 0; i < 200000; i++ {
+		f := math.Float64frombits(rng.Uint64())
+		if i%2 == 0 {
+			f = float64(rng.Int63n(1e9)) / math.Pow(10, float64(rng.Intn(20)))
+		}
+
+		for _, c := range []struct {
+			format FloatFormat
+			fmt    byte
+		}{
+			{FloatDecimal, 'f'},
+			{FloatExponent, 'e'},
+		} {
+			expected := strconv.FormatFloat(f, c.fmt, -1, 64)
+
+			if actual := dst[:CopyFloat64(dst[:], f, c.format)]; string(actual) != expected {
+				t.Fatalf("[%b]: expected [%s], got [%s]", f, expected, actual)
+			}
+
+			if actual := AppendFloat64(nil, f, c.format); string(actual) != expected {
+				t.Fatalf("[%b]: expected [%s], got [%s]", f, expected, actual)
+			}
+		}
+	}
+}
+
+func BenchmarkStrconvFormatFloat64(b *testing.B) {
+	dst := make([]byte, 0, 32)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendFloat(dst, math.Pi, 'e', -1, 64)
+	}
+}
+
+func BenchmarkRushFormatFloat64(b *testing.B) {
+	dst := make([]byte, 0, 32)
+	for n := 0; n < b.N; n++ {
+		_ = AppendFloat64(dst, math.Pi, FloatExponent)
+	}
+}
+
+func BenchmarkStrconvFormatFloat64Short(b *testing.B) {
+	dst := make([]byte, 0, 32)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendFloat(dst, 0.1, 'f', -1, 64)
+	}
+}
+
+func BenchmarkRushFormatFloat64Short(b *testing.B) {
+	dst := make([]byte, 0, 32)
+	for n := 0; n < b.N; n++ {
+		_ = AppendFloat64(dst, 0.1, FloatDecimal)
+	}
+}
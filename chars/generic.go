@@ -0,0 +1,119 @@
+package chars
+
+import "unsafe"
+
+// Generic wrappers around the typed functions, for callers which are generic themselves.
+//
+// The dispatch is done on the size of T. Instantiations are stenciled per underlying type,
+// so unsafe.Sizeof is a constant within each of them and the switch gets folded away -
+// what remains is a direct call to the specialised function of the matching width.
+
+// Unsigned is a constraint that permits any unsigned integer type.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// Signed is a constraint that permits any signed integer type.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// ParseUnsigned works like ParseUint64, ParseUint32, ParseUint16 or ParseUint8,
+// depending on the width of T.
+func ParseUnsigned[T Unsigned](s string) (T, bool) {
+	var v T
+	switch unsafe.Sizeof(v) {
+	case 1:
+		u, ok := ParseUint8(s)
+		return T(u), ok
+	case 2:
+		u, ok := ParseUint16(s)
+		return T(u), ok
+	case 4:
+		u, ok := ParseUint32(s)
+		return T(u), ok
+	default:
+		u, ok := ParseUint64(s)
+		return T(u), ok
+	}
+}
+
+// ParseSigned works like ParseInt64, ParseInt32, ParseInt16 or ParseInt8,
+// depending on the width of T.
+func ParseSigned[T Signed](s string) (T, bool) {
+	var v T
+	switch unsafe.Sizeof(v) {
+	case 1:
+		i, ok := ParseInt8(s)
+		return T(i), ok
+	case 2:
+		i, ok := ParseInt16(s)
+		return T(i), ok
+	case 4:
+		i, ok := ParseInt32(s)
+		return T(i), ok
+	default:
+		i, ok := ParseInt64(s)
+		return T(i), ok
+	}
+}
+
+// CopyUnsigned works like CopyUint64, CopyUint32, CopyUint16 or CopyUint8,
+// depending on the width of T.
+func CopyUnsigned[T Unsigned](dst []byte, v T) int {
+	switch unsafe.Sizeof(v) {
+	case 1:
+		return CopyUint8(dst, uint8(v))
+	case 2:
+		return CopyUint16(dst, uint16(v))
+	case 4:
+		return CopyUint32(dst, uint32(v))
+	default:
+		return CopyUint64(dst, uint64(v))
+	}
+}
+
+// CopySigned works like CopyInt64, CopyInt32, CopyInt16 or CopyInt8,
+// depending on the width of T.
+func CopySigned[T Signed](dst []byte, v T) int {
+	switch unsafe.Sizeof(v) {
+	case 1:
+		return CopyInt8(dst, int8(v))
+	case 2:
+		return CopyInt16(dst, int16(v))
+	case 4:
+		return CopyInt32(dst, int32(v))
+	default:
+		return CopyInt64(dst, int64(v))
+	}
+}
+
+// AppendUnsigned works like AppendUint64, AppendUint32, AppendUint16 or AppendUint8,
+// depending on the width of T.
+func AppendUnsigned[T Unsigned](dst []byte, v T) []byte {
+	switch unsafe.Sizeof(v) {
+	case 1:
+		return AppendUint8(dst, uint8(v))
+	case 2:
+		return AppendUint16(dst, uint16(v))
+	case 4:
+		return AppendUint32(dst, uint32(v))
+	default:
+		return AppendUint64(dst, uint64(v))
+	}
+}
+
+// AppendSigned works like AppendInt64, AppendInt32, AppendInt16 or AppendInt8,
+// depending on the width of T.
+func AppendSigned[T Signed](dst []byte, v T) []byte {
+	switch unsafe.Sizeof(v) {
+	case 1:
+		return AppendInt8(dst, int8(v))
+	case 2:
+		return AppendInt16(dst, int16(v))
+	case 4:
+		return AppendInt32(dst, int32(v))
+	default:
+		return AppendInt64(dst, int64(v))
+	}
+}
@@ -0,0 +1,109 @@
+package chars
+
+import (
+	"strconv"
+	"testing"
+)
+
+type testID uint32
+
+func TestParseUnsigned(t *testing.T) {
+	if v, ok := ParseUnsigned[uint8](dec8max); v != uint8Max || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", uint8Max, v, ok)
+	}
+
+	if v, ok := ParseUnsigned[uint16](dec8max + "0"); v != 2550 || !ok {
+		t.Errorf("expected [2550, true], got [%d, %t]", v, ok)
+	}
+
+	if v, ok := ParseUnsigned[testID](dec32max); v != uint32Max || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", uint64(uint32Max), v, ok)
+	}
+
+	if v, ok := ParseUnsigned[testID](dec64max); v != uint32Max || ok {
+		t.Errorf("expected [%d, false], got [%d, %t]", uint64(uint32Max), v, ok)
+	}
+
+	if v, ok := ParseUnsigned[uint64](dec64max); v != uint64Max || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", uint64(uint64Max), v, ok)
+	}
+
+	if v, ok := ParseUnsigned[uint](strconv.FormatUint(uint64(^uint(0)), 10)); v != ^uint(0) || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", ^uint(0), v, ok)
+	}
+}
+
+func TestParseSigned(t *testing.T) {
+	if v, ok := ParseSigned[int8](deci8min); v != int8Min || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", int8Min, v, ok)
+	}
+
+	if v, ok := ParseSigned[int16](deci8max); v != int8Max || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", int8Max, v, ok)
+	}
+
+	if v, ok := ParseSigned[int32](deci64min); v != int32Min || ok {
+		t.Errorf("expected [%d, false], got [%d, %t]", int32Min, v, ok)
+	}
+
+	if v, ok := ParseSigned[int64](deci64min); v != int64Min || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", int64(int64Min), v, ok)
+	}
+
+	if v, ok := ParseSigned[int]("-1"); v != -1 || !ok {
+		t.Errorf("expected [-1, true], got [%d, %t]", v, ok)
+	}
+}
+
+func TestCopyUnsigned(t *testing.T) {
+	buf := make([]byte, 20)
+
+	if n := CopyUnsigned(buf, uint8(u8max)); string(buf[:n]) != dec8max {
+		t.Errorf("expected [%s], got [%s]", dec8max, buf[:n])
+	}
+
+	if n := CopyUnsigned(buf, testID(u32max)); string(buf[:n]) != dec32max {
+		t.Errorf("expected [%s], got [%s]", dec32max, buf[:n])
+	}
+
+	if n := CopyUnsigned(buf, uint64(u64max)); string(buf[:n]) != dec64max {
+		t.Errorf("expected [%s], got [%s]", dec64max, buf[:n])
+	}
+
+	if out := AppendUnsigned([]byte("n="), uint16(u16max)); string(out) != "n="+dec16max {
+		t.Errorf("expected [n=%s], got [%s]", dec16max, out)
+	}
+}
+
+func TestCopySigned(t *testing.T) {
+	buf := make([]byte, 20)
+
+	if n := CopySigned(buf, int8(int8Min)); string(buf[:n]) != deci8min {
+		t.Errorf("expected [%s], got [%s]", deci8min, buf[:n])
+	}
+
+	if n := CopySigned(buf, int16(int16Min)); string(buf[:n]) != deci16min {
+		t.Errorf("expected [%s], got [%s]", deci16min, buf[:n])
+	}
+
+	if n := CopySigned(buf, int64(int64Min)); string(buf[:n]) != deci64min {
+		t.Errorf("expected [%s], got [%s]", deci64min, buf[:n])
+	}
+
+	if out := AppendSigned([]byte("n="), int32(int32Min)); string(out) != "n="+deci32min {
+		t.Errorf("expected [n=%s], got [%s]", deci32min, out)
+	}
+}
+
+func BenchmarkRushParseUnsigned64Max(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseUnsigned[uint64](dec64max)
+	}
+}
+
+func BenchmarkRushCopyUnsigned64(b *testing.B) {
+	by := make([]byte, 20)
+	for n := 0; n < b.N; n++ {
+		_ = CopyUnsigned(by, uint64(uint64Max))
+	}
+}
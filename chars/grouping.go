@@ -0,0 +1,128 @@
+package chars
+
+// Digit grouping, e.g. 18,446,744,073,709,551,615 or - with Indian (lakh/crore)
+// grouping - 1,84,46,744.
+//
+// The digits get produced by the same LUT-based routine as CopyUint64 and then copied out
+// group by group, so grouping costs little more than the separators themselves.
+
+// Grouping describes how the digits of a number get grouped.
+type Grouping struct {
+	// Sep is the separator between groups. Can be any UTF-8 sequence, e.g. "," or " "
+	// (narrow no-break space).
+	Sep string
+
+	// Primary is the size of the rightmost group. A Primary of 0 (or less) disables grouping.
+	Primary int
+
+	// Secondary is the size of all other groups. A Secondary of 0 (or less) means the same
+	// as Primary.
+	Secondary int
+}
+
+var (
+	// GroupThousands groups by thousands, separated by commas (1,234,567).
+	GroupThousands = Grouping{Sep: ",", Primary: 3}
+
+	// GroupIndian groups by thousands, then lakhs and crores, separated by commas (12,34,567).
+	GroupIndian = Grouping{Sep: ",", Primary: 3, Secondary: 2}
+)
+
+// Len returns the number of bytes n digits take up when grouped according to g.
+func (g Grouping) Len(n int) int {
+	primary, secondary := g.sizes()
+	if primary <= 0 || n <= primary {
+		return n
+	}
+
+	return n + (1+(n-primary-1)/secondary)*len(g.Sep)
+}
+
+func (g Grouping) sizes() (primary, secondary int) {
+	if g.Secondary <= 0 {
+		return g.Primary, g.Primary
+	}
+
+	return g.Primary, g.Secondary
+}
+
+// CopyUint64Grouped copies the base10 representation of a uint64, grouped according to g,
+// into dst up to len(dst), discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+func CopyUint64Grouped(dst []byte, u uint64, g Grouping) int {
+	var b [uint64Digits]byte
+	return copyGrouped(dst, "", b[formatUint64(b[:], u):], g)
+}
+
+// CopyInt64Grouped copies the base10 representation of an int64, grouped according to g,
+// into dst up to len(dst), discarding all overflowing bytes. Negative values get prefixed
+// with '-'.
+//
+// Returns the number of bytes copied to dst.
+func CopyInt64Grouped(dst []byte, i int64, g Grouping) int {
+	var b [uint64Digits]byte
+	if i >= 0 {
+		return copyGrouped(dst, "", b[formatUint64(b[:], uint64(i)):], g)
+	}
+
+	return copyGrouped(dst, "-", b[formatUint64(b[:], uint64(-i)):], g)
+}
+
+// AppendUint64Grouped appends the base10 representation of a uint64, grouped according to g,
+// to dst and returns the extended buffer, growing it if necessary.
+func AppendUint64Grouped(dst []byte, u uint64, g Grouping) []byte {
+	var b [uint64Digits]byte
+	return appendGrouped(dst, "", b[formatUint64(b[:], u):], g)
+}
+
+// AppendInt64Grouped appends the base10 representation of an int64, grouped according to g,
+// to dst and returns the extended buffer, growing it if necessary. Negative values get
+// prefixed with '-'.
+func AppendInt64Grouped(dst []byte, i int64, g Grouping) []byte {
+	var b [uint64Digits]byte
+	if i >= 0 {
+		return appendGrouped(dst, "", b[formatUint64(b[:], uint64(i)):], g)
+	}
+
+	return appendGrouped(dst, "-", b[formatUint64(b[:], uint64(-i)):], g)
+}
+
+func appendGrouped(dst []byte, sign string, digits []byte, g Grouping) []byte {
+	n := len(sign) + g.Len(len(digits))
+	dst = grow(dst, n)
+	l := len(dst)
+
+	return dst[:l+copyGrouped(dst[l:l+n], sign, digits, g)]
+}
+
+// copyGrouped copies the sign followed by the grouped digits into dst up to len(dst).
+func copyGrouped(dst []byte, sign string, digits []byte, g Grouping) int {
+	primary, secondary := g.sizes()
+	n := copy(dst, sign)
+
+	if primary <= 0 || len(digits) <= primary {
+		return n + copy(dst[n:], digits)
+	}
+
+	// What remains after the leading group must be a multiple of secondary plus primary.
+	lead := (len(digits) - primary) % secondary
+	if lead == 0 {
+		lead = secondary
+	}
+
+	for {
+		n += copy(dst[n:], digits[:lead])
+		digits = digits[lead:]
+
+		if len(digits) == 0 || n == len(dst) {
+			return n
+		}
+
+		n += copy(dst[n:], g.Sep)
+
+		if lead = secondary; len(digits) == primary {
+			lead = primary
+		}
+	}
+}
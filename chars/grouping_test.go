@@ -0,0 +1,79 @@
+package chars
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCopyUint64Grouped(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		in       uint64
+		g        Grouping
+		expected []byte
+	}{
+		{"min", 0, GroupThousands, []byte("0")},
+		{"no-group", 999, GroupThousands, []byte("999")},
+		{"one-group", 1000, GroupThousands, []byte("1,000")},
+		{"max", u64max, GroupThousands, []byte("18,446,744,073,709,551,615")},
+		{"indian", 18446744, GroupIndian, []byte("1,84,46,744")},
+		{"indian-crore", 123456789, GroupIndian, []byte("12,34,56,789")},
+		{"indian-small", 12345, GroupIndian, []byte("12,345")},
+		{"utf8-sep", 1234567, Grouping{Sep: " ", Primary: 3}, []byte("1 234 567")},
+		{"no-sep", 1234567, Grouping{Primary: 3}, []byte("1234567")},
+		{"disabled", 1234567, Grouping{Sep: ","}, []byte("1234567")},
+		{"pairs", 1234567, Grouping{Sep: " ", Primary: 4, Secondary: 2}, []byte("1 23 4567")},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := make([]byte, 64)
+			actualLen := CopyUint64Grouped(buf, c.in, c.g)
+
+			if !bytes.Equal(buf[:actualLen], c.expected) {
+				t.Errorf("expected [%s], got [%s]", c.expected, buf[:actualLen])
+			}
+
+			if l := c.g.Len(DecimalLen64(c.in)); l != len(c.expected) {
+				t.Errorf("expected Len [%d], got [%d]", len(c.expected), l)
+			}
+
+			if out := AppendUint64Grouped([]byte("n="), c.in, c.g); string(out) != "n="+string(c.expected) {
+				t.Errorf("expected [n=%s], got [%s]", c.expected, out)
+			}
+		})
+	}
+}
+
+func TestCopyInt64Grouped(t *testing.T) {
+	buf := make([]byte, 64)
+
+	if n := CopyInt64Grouped(buf, int64Min, GroupThousands); string(buf[:n]) != "-9,223,372,036,854,775,808" {
+		t.Errorf("expected [-9,223,372,036,854,775,808], got [%s]", buf[:n])
+	}
+
+	if n := CopyInt64Grouped(buf, 1234, GroupThousands); string(buf[:n]) != "1,234" {
+		t.Errorf("expected [1,234], got [%s]", buf[:n])
+	}
+
+	if out := AppendInt64Grouped(nil, -1234567, GroupIndian); string(out) != "-12,34,567" {
+		t.Errorf("expected [-12,34,567], got [%s]", out)
+	}
+
+	// Truncation keeps the leading bytes, like the other Copy functions.
+	if n := CopyInt64Grouped(buf[:4], -1234567, GroupThousands); string(buf[:n]) != "-1,2" {
+		t.Errorf("expected [-1,2], got [%s]", buf[:n])
+	}
+
+	if n := CopyUint64Grouped(buf[:2], 1234567, Grouping{Sep: " ", Primary: 3}); string(buf[:n]) != "1\xe2" {
+		t.Errorf("expected [1\\xe2], got [%q]", buf[:n])
+	}
+}
+
+func BenchmarkRushCopyUint64Grouped(b *testing.B) {
+	by := make([]byte, 32)
+	for n := 0; n < b.N; n++ {
+		_ = CopyUint64Grouped(by, uint64Max, GroupThousands)
+	}
+}
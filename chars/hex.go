@@ -0,0 +1,261 @@
+package chars
+
+import "math/bits"
+
+// Hexadecimal (base16) conversions.
+//
+// Parsing maps each character through a 256-entry table, so that digits, lowercase and
+// uppercase letters all take the same path. Formatting works like the base10 one, writing two
+// digits per step from a table of all 256 byte values, analogous to smalls.
+
+// HexFormat selects the letter case and width of hexadecimal output. The zero value means
+// lowercase without padding.
+type HexFormat uint8
+
+const (
+	// HexUpper uses A-F instead of a-f.
+	HexUpper HexFormat = 1 << iota
+
+	// HexPadded zero-pads to the full width of the type, e.g. 16 digits for 64-bit values.
+	HexPadded
+)
+
+const (
+	hex64Digits = 16
+	hex32Digits = 8
+	hex16Digits = 4
+	hex8Digits  = 2
+
+	// Marks non-hex characters in unhex.
+	hexInvalid = 0xFF
+)
+
+// LUTs for all byte values, as two hex digits each.
+const (
+	hexLower = "000102030405060708090a0b0c0d0e0f" +
+		"101112131415161718191a1b1c1d1e1f" +
+		"202122232425262728292a2b2c2d2e2f" +
+		"303132333435363738393a3b3c3d3e3f" +
+		"404142434445464748494a4b4c4d4e4f" +
+		"505152535455565758595a5b5c5d5e5f" +
+		"606162636465666768696a6b6c6d6e6f" +
+		"707172737475767778797a7b7c7d7e7f" +
+		"808182838485868788898a8b8c8d8e8f" +
+		"909192939495969798999a9b9c9d9e9f" +
+		"a0a1a2a3a4a5a6a7a8a9aaabacadaeaf" +
+		"b0b1b2b3b4b5b6b7b8b9babbbcbdbebf" +
+		"c0c1c2c3c4c5c6c7c8c9cacbcccdcecf" +
+		"d0d1d2d3d4d5d6d7d8d9dadbdcdddedf" +
+		"e0e1e2e3e4e5e6e7e8e9eaebecedeeef" +
+		"f0f1f2f3f4f5f6f7f8f9fafbfcfdfeff"
+
+	hexUpper = "000102030405060708090A0B0C0D0E0F" +
+		"101112131415161718191A1B1C1D1E1F" +
+		"202122232425262728292A2B2C2D2E2F" +
+		"303132333435363738393A3B3C3D3E3F" +
+		"404142434445464748494A4B4C4D4E4F" +
+		"505152535455565758595A5B5C5D5E5F" +
+		"606162636465666768696A6B6C6D6E6F" +
+		"707172737475767778797A7B7C7D7E7F" +
+		"808182838485868788898A8B8C8D8E8F" +
+		"909192939495969798999A9B9C9D9E9F" +
+		"A0A1A2A3A4A5A6A7A8A9AAABACADAEAF" +
+		"B0B1B2B3B4B5B6B7B8B9BABBBCBDBEBF" +
+		"C0C1C2C3C4C5C6C7C8C9CACBCCCDCECF" +
+		"D0D1D2D3D4D5D6D7D8D9DADBDCDDDEDF" +
+		"E0E1E2E3E4E5E6E7E8E9EAEBECEDEEEF" +
+		"F0F1F2F3F4F5F6F7F8F9FAFBFCFDFEFF"
+)
+
+// unhex maps ASCII characters to their value as a hex digit, or hexInvalid.
+var unhex = func() (t [256]byte) {
+	for i := range t {
+		t[i] = hexInvalid
+	}
+
+	for i := byte(0); i < 10; i++ {
+		t['0'+i] = i
+	}
+
+	for i := byte(0); i < 6; i++ {
+		t['a'+i], t['A'+i] = 10+i, 10+i
+	}
+
+	return t
+}()
+
+// ParseHex64 takes an unsigned integer encoded as base16 (hexadecimal, without prefix) and
+// converts it to an unsigned 64-bit integer. Digits above 9 may be lower or upper case.
+//
+// The max length of the string is 16 characters, not counting leading zeros. If it
+// overflows, uint64Max and false get returned.
+// If the string contains non-hex ASCII characters, 0 and false get returned.
+func ParseHex64(s string) (uint64, bool) {
+	return parseHex(s, hex64Digits)
+}
+
+// ParseHex32 takes an unsigned integer encoded as base16 (hexadecimal, without prefix) and
+// converts it to an unsigned 32-bit integer. Digits above 9 may be lower or upper case.
+//
+// The max length of the string is 8 characters, not counting leading zeros. If it
+// overflows, uint32Max and false get returned.
+// If the string contains non-hex ASCII characters, 0 and false get returned.
+func ParseHex32(s string) (uint32, bool) {
+	u, ok := parseHex(s, hex32Digits)
+	return uint32(u), ok
+}
+
+// ParseHex16 takes an unsigned integer encoded as base16 (hexadecimal, without prefix) and
+// converts it to an unsigned 16-bit integer. Digits above 9 may be lower or upper case.
+//
+// The max length of the string is 4 characters, not counting leading zeros. If it
+// overflows, uint16Max and false get returned.
+// If the string contains non-hex ASCII characters, 0 and false get returned.
+func ParseHex16(s string) (uint16, bool) {
+	u, ok := parseHex(s, hex16Digits)
+	return uint16(u), ok
+}
+
+// ParseHex8 takes an unsigned integer encoded as base16 (hexadecimal, without prefix) and
+// converts it to an unsigned 8-bit integer. Digits above 9 may be lower or upper case.
+//
+// The max length of the string is 2 characters, not counting leading zeros. If it
+// overflows, uint8Max and false get returned.
+// If the string contains non-hex ASCII characters, 0 and false get returned.
+func ParseHex8(s string) (uint8, bool) {
+	u, ok := parseHex(s, hex8Digits)
+	return uint8(u), ok
+}
+
+// parseHex parses up to digits hex digits. Since every digit is worth exactly 4 bits, the
+// length alone decides about overflow, which gets reported as all bits set - uint64Max
+// truncated to the width of the caller.
+func parseHex(s string, digits int) (uint64, bool) {
+	if len(s) == 0 {
+		return 0, false
+	}
+
+	if len(s) > digits {
+		if s[0] == '0' {
+			return parseHex(skipZeros(s), digits)
+		}
+
+		return uint64Max, false
+	}
+
+	var u uint64
+	for i := 0; i < len(s); i++ {
+		v := unhex[s[i]]
+		if v == hexInvalid {
+			return 0, false
+		}
+
+		u = u<<4 | uint64(v)
+	}
+
+	return u, true
+}
+
+// CopyHex64 copies the base16 representation of a uint64, laid out according to f, into dst
+// up to len(dst), discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+//
+// Works like strconv.AppendUint(dst, u, 16), but puts values starting at the beginning of dst.
+func CopyHex64(dst []byte, u uint64, f HexFormat) int {
+	return copyHex(dst, u, hex64Digits, f)
+}
+
+// CopyHex32 copies the base16 representation of a uint32, laid out according to f, into dst
+// up to len(dst), discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+func CopyHex32(dst []byte, u uint32, f HexFormat) int {
+	return copyHex(dst, uint64(u), hex32Digits, f)
+}
+
+// CopyHex16 copies the base16 representation of a uint16, laid out according to f, into dst
+// up to len(dst), discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+func CopyHex16(dst []byte, u uint16, f HexFormat) int {
+	return copyHex(dst, uint64(u), hex16Digits, f)
+}
+
+// CopyHex8 copies the base16 representation of a uint8, laid out according to f, into dst
+// up to len(dst), discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+func CopyHex8(dst []byte, u uint8, f HexFormat) int {
+	return copyHex(dst, uint64(u), hex8Digits, f)
+}
+
+// AppendHex64 appends the base16 representation of a uint64, laid out according to f, to dst
+// and returns the extended buffer, growing it if necessary.
+func AppendHex64(dst []byte, u uint64, f HexFormat) []byte {
+	return appendHex(dst, u, hex64Digits, f)
+}
+
+// AppendHex32 appends the base16 representation of a uint32, laid out according to f, to dst
+// and returns the extended buffer, growing it if necessary.
+func AppendHex32(dst []byte, u uint32, f HexFormat) []byte {
+	return appendHex(dst, uint64(u), hex32Digits, f)
+}
+
+// AppendHex16 appends the base16 representation of a uint16, laid out according to f, to dst
+// and returns the extended buffer, growing it if necessary.
+func AppendHex16(dst []byte, u uint16, f HexFormat) []byte {
+	return appendHex(dst, uint64(u), hex16Digits, f)
+}
+
+// AppendHex8 appends the base16 representation of a uint8, laid out according to f, to dst
+// and returns the extended buffer, growing it if necessary.
+func AppendHex8(dst []byte, u uint8, f HexFormat) []byte {
+	return appendHex(dst, uint64(u), hex8Digits, f)
+}
+
+func appendHex(dst []byte, u uint64, width int, f HexFormat) []byte {
+	dst = grow(dst, width)
+	n := len(dst)
+
+	return dst[:n+copyHex(dst[n:n+width], u, width, f)]
+}
+
+func copyHex(dst []byte, u uint64, width int, f HexFormat) int {
+	lut := hexLower
+	if f&HexUpper != 0 {
+		lut = hexUpper
+	}
+
+	n := width
+	if f&HexPadded == 0 {
+		// u|1 has the same digit count as u, except for 0 which now counts as 1 digit.
+		n = (bits.Len64(u|1) + 3) >> 2
+	}
+
+	// As with CopyUint64, only go through an intermediate buffer if dst is too short.
+	if n <= len(dst) {
+		formatHex(dst[:n], u, lut)
+		return n
+	}
+
+	var b [hex64Digits]byte
+	formatHex(b[:n], u, lut)
+
+	return copy(dst, b[:n])
+}
+
+// formatHex writes the len(b) least significant hex digits of u into b, using lut for
+// the digits.
+func formatHex(b []byte, u uint64, lut string) {
+	i := len(b)
+	for ; i > 1; i -= 2 {
+		q := (u & 0xFF) * 2
+		u >>= 8
+		b[i-2], b[i-1] = lut[q], lut[q+1]
+	}
+
+	if i == 1 {
+		b[0] = lut[(u&0xF)*2+1]
+	}
+}
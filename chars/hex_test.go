@@ -0,0 +1,194 @@
+package chars
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const (
+	hex64max = "ffffffffffffffff"
+	hex64mid = "4bf92f3577b34da6"
+)
+
+func TestParseHex64(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expected   uint64
+		expectedOk bool
+	}{
+		{"empty", "", 0, false},
+		{"zero", "0", 0, true},
+		{"digit", "a", 10, true},
+		{"upper", "ABCDEF", 0xABCDEF, true},
+		{"mixed", "aBcD", 0xABCD, true},
+		{"leading-zeros", "000000000000002a", 42, true},
+		{"mid", hex64mid, 0x4BF92F3577B34DA6, true},
+		{"max", hex64max, uint64Max, true},
+		{"overflow-len", "10000000000000000", uint64Max, false},
+		{"leading-zeros-long", "0000000000000000002a", 42, true},
+		{"syntax", "12g4", 0, false},
+		{"syntax-prefix", "0x1f", 0, false},
+		{"syntax-sign", "-1", 0, false},
+		{"syntax-space", "1 ", 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseHex64(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%x], got [%x]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParseHexWidths(t *testing.T) {
+	for _, c := range []struct {
+		in         string
+		expected   uint64
+		expectedOk bool
+		parse      func(string) (uint64, bool)
+	}{
+		{"ffffffff", uint32Max, true, func(s string) (uint64, bool) { u, ok := ParseHex32(s); return uint64(u), ok }},
+		{"100000000", uint32Max, false, func(s string) (uint64, bool) { u, ok := ParseHex32(s); return uint64(u), ok }},
+		{"fFfF", uint16Max, true, func(s string) (uint64, bool) { u, ok := ParseHex16(s); return uint64(u), ok }},
+		{"10000", uint16Max, false, func(s string) (uint64, bool) { u, ok := ParseHex16(s); return uint64(u), ok }},
+		{"x", 0, false, func(s string) (uint64, bool) { u, ok := ParseHex16(s); return uint64(u), ok }},
+		{"FF", uint8Max, true, func(s string) (uint64, bool) { u, ok := ParseHex8(s); return uint64(u), ok }},
+		{"100", uint8Max, false, func(s string) (uint64, bool) { u, ok := ParseHex8(s); return uint64(u), ok }},
+		{"00ff", uint8Max, true, func(s string) (uint64, bool) { u, ok := ParseHex8(s); return uint64(u), ok }},
+		{"", 0, false, func(s string) (uint64, bool) { u, ok := ParseHex8(s); return uint64(u), ok }},
+	} {
+		if actual, ok := c.parse(c.in); actual != c.expected || ok != c.expectedOk {
+			t.Errorf("[%s]: expected [%x, %t], got [%x, %t]", c.in, c.expected, c.expectedOk, actual, ok)
+		}
+	}
+}
+
+func TestCopyHex64(t *testing.T) {
+	for _, c := range []struct {
+		name     string
+		in       uint64
+		f        HexFormat
+		size     int
+		expected string
+	}{
+		{"zero", 0, 0, 16, "0"},
+		{"zero-padded", 0, HexPadded, 16, "0000000000000000"},
+		{"odd", 0xabc, 0, 16, "abc"},
+		{"odd-upper", 0xabc, HexUpper, 16, "ABC"},
+		{"odd-padded", 0xabc, HexPadded | HexUpper, 16, "0000000000000ABC"},
+		{"mid", 0x4BF92F3577B34DA6, 0, 16, hex64mid},
+		{"max", uint64Max, 0, 16, hex64max},
+		{"truncated", 0xabc, 0, 2, "ab"},
+		{"truncated-padded", 0xabc, HexPadded, 4, "0000"},
+		{"empty", 0xabc, 0, 0, ""},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			dst := make([]byte, c.size)
+			if actual := dst[:CopyHex64(dst, c.in, c.f)]; string(actual) != c.expected {
+				t.Errorf("expected [%s], got [%s]", c.expected, actual)
+			}
+
+			if c.size < hex64Digits {
+				return
+			}
+
+			if actual := AppendHex64([]byte("x"), c.in, c.f); string(actual) != "x"+c.expected {
+				t.Errorf("expected [x%s], got [%s]", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestCopyHexWidths(t *testing.T) {
+	for _, c := range []struct {
+		actual   []byte
+		expected string
+	}{
+		{AppendHex32(nil, 0xbeef, 0), "beef"},
+		{AppendHex32(nil, 0xbeef, HexPadded), "0000beef"},
+		{AppendHex32(nil, uint32Max, HexUpper), "FFFFFFFF"},
+		{AppendHex16(nil, 0xf, HexPadded), "000f"},
+		{AppendHex16(nil, uint16Max, 0), "ffff"},
+		{AppendHex8(nil, 0x7, 0), "7"},
+		{AppendHex8(nil, 0x7, HexPadded), "07"},
+		{AppendHex8(nil, uint8Max, HexUpper), "FF"},
+	} {
+		if string(c.actual) != c.expected {
+			t.Errorf("expected [%s], got [%s]", c.expected, c.actual)
+		}
+	}
+
+	var dst [8]byte
+	if n := CopyHex32(dst[:], 0xbeef, 0); string(dst[:n]) != "beef" {
+		t.Errorf("expected [beef], got [%s]", dst[:n])
+	}
+
+	if n := CopyHex16(dst[:], 0xbeef, HexUpper); string(dst[:n]) != "BEEF" {
+		t.Errorf("expected [BEEF], got [%s]", dst[:n])
+	}
+
+	if n := CopyHex8(dst[:], 0xe, HexPadded); string(dst[:n]) != "0e" {
+		t.Errorf("expected [0e], got [%s]", dst[:n])
+	}
+}
+
+func TestHex64Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(6))
+
+	for i := 0; i < 100000; i++ {
+		u := rng.Uint64() >> rng.Intn(64)
+		expected := strconv.FormatUint(u, 16)
+
+		if actual := string(AppendHex64(nil, u, 0)); actual != expected {
+			t.Fatalf("expected [%s], got [%s]", expected, actual)
+		}
+
+		if actual := string(AppendHex64(nil, u, HexUpper)); actual != strings.ToUpper(expected) {
+			t.Fatalf("expected [%s], got [%s]", strings.ToUpper(expected), actual)
+		}
+
+		if actual, ok := ParseHex64(strings.ToUpper(expected)); !ok || actual != u {
+			t.Fatalf("[%s]: expected [%x, true], got [%x, %t]", expected, u, actual, ok)
+		}
+	}
+}
+
+func BenchmarkStrconvParseHex64(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseUint(hex64mid, 16, 64)
+	}
+}
+
+func BenchmarkRushParseHex64(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseHex64(hex64mid)
+	}
+}
+
+func BenchmarkStrconvAppendHex64(b *testing.B) {
+	dst := make([]byte, 0, 16)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendUint(dst, 0x4BF92F3577B34DA6, 16)
+	}
+}
+
+func BenchmarkRushAppendHex64(b *testing.B) {
+	dst := make([]byte, 0, 16)
+	for n := 0; n < b.N; n++ {
+		_ = AppendHex64(dst, 0x4BF92F3577B34DA6, 0)
+	}
+}
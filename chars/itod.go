@@ -0,0 +1,263 @@
+package chars
+
+// CopyInt64 copies the base10 representation of an int64 into dst up to len(dst),
+// discarding all overflowing bytes. Negative values get prefixed with '-'.
+//
+// Returns the number of bytes copied to dst.
+//
+// Works similar to strconv.AppendInt() but puts values starting at the beginning of dst
+// instead of appending and does not grow dst.
+func CopyInt64(dst []byte, i int64) int {
+	if i >= 0 {
+		return CopyUint64(dst, uint64(i))
+	}
+
+	if len(dst) == 0 {
+		return 0
+	}
+
+	dst[0] = '-'
+
+	// Negation of int64Min yields int64Min again, but its bit pattern reinterpreted
+	// as unsigned is exactly the magnitude we're after.
+	return 1 + CopyUint64(dst[1:], uint64(-i))
+}
+
+// CopyInt32 copies the base10 representation of an int32 into dst up to len(dst),
+// discarding all overflowing bytes. Negative values get prefixed with '-'.
+//
+// Returns the number of bytes copied to dst.
+//
+// Works similar to strconv.AppendInt() but puts values starting at the beginning of dst
+// instead of appending and does not grow dst.
+func CopyInt32(dst []byte, i int32) int {
+	if i >= 0 {
+		return CopyUint32(dst, uint32(i))
+	}
+
+	if len(dst) == 0 {
+		return 0
+	}
+
+	dst[0] = '-'
+
+	return 1 + CopyUint32(dst[1:], uint32(-i))
+}
+
+// CopyInt16 copies the base10 representation of an int16 into dst up to len(dst),
+// discarding all overflowing bytes. Negative values get prefixed with '-'.
+//
+// Returns the number of bytes copied to dst.
+//
+// Works similar to strconv.AppendInt() but puts values starting at the beginning of dst
+// instead of appending and does not grow dst.
+func CopyInt16(dst []byte, i int16) int {
+	if i >= 0 {
+		return CopyUint16(dst, uint16(i))
+	}
+
+	if len(dst) == 0 {
+		return 0
+	}
+
+	dst[0] = '-'
+
+	return 1 + CopyUint16(dst[1:], uint16(-i))
+}
+
+// CopyInt8 copies the base10 representation of an int8 into dst up to len(dst),
+// discarding all overflowing bytes. Negative values get prefixed with '-'.
+//
+// Returns the number of bytes copied to dst.
+//
+// Works similar to strconv.AppendInt() but puts values starting at the beginning of dst
+// instead of appending and does not grow dst.
+func CopyInt8(dst []byte, i int8) int {
+	if i >= 0 {
+		return CopyUint8(dst, uint8(i))
+	}
+
+	if len(dst) == 0 {
+		return 0
+	}
+
+	dst[0] = '-'
+
+	return 1 + CopyUint8(dst[1:], uint8(-i))
+}
+
+// CopyInt64Checked copies the base10 representation of an int64 into dst if it fits.
+//
+// Returns the number of bytes copied to dst and true. If dst is too short, nothing gets
+// copied and the number of bytes required along with false get returned instead.
+func CopyInt64Checked(dst []byte, i int64) (int, bool) {
+	if n := DecimalLenInt64(i); n > len(dst) {
+		return n, false
+	}
+
+	return CopyInt64(dst, i), true
+}
+
+// CopyInt32Checked copies the base10 representation of an int32 into dst if it fits.
+//
+// Returns the number of bytes copied to dst and true. If dst is too short, nothing gets
+// copied and the number of bytes required along with false get returned instead.
+func CopyInt32Checked(dst []byte, i int32) (int, bool) {
+	if n := DecimalLenInt32(i); n > len(dst) {
+		return n, false
+	}
+
+	return CopyInt32(dst, i), true
+}
+
+// CopyInt16Checked copies the base10 representation of an int16 into dst if it fits.
+//
+// Returns the number of bytes copied to dst and true. If dst is too short, nothing gets
+// copied and the number of bytes required along with false get returned instead.
+func CopyInt16Checked(dst []byte, i int16) (int, bool) {
+	if n := DecimalLenInt16(i); n > len(dst) {
+		return n, false
+	}
+
+	return CopyInt16(dst, i), true
+}
+
+// CopyInt8Checked copies the base10 representation of an int8 into dst if it fits.
+//
+// Returns the number of bytes copied to dst and true. If dst is too short, nothing gets
+// copied and the number of bytes required along with false get returned instead.
+func CopyInt8Checked(dst []byte, i int8) (int, bool) {
+	if n := DecimalLenInt8(i); n > len(dst) {
+		return n, false
+	}
+
+	return CopyInt8(dst, i), true
+}
+
+// CopyInt64Backward copies the base10 representation of an int64 into dst right-aligned,
+// i.e. with the last digit at the end of dst. Negative values get prefixed with '-'.
+//
+// Returns the index in dst of the first byte. If dst is too short, nothing gets copied
+// and the returned index is negative, by the number of bytes missing.
+func CopyInt64Backward(dst []byte, i int64) int {
+	if i >= 0 {
+		return CopyUint64Backward(dst, uint64(i))
+	}
+
+	j := len(dst) - DecimalLenInt64(i)
+	if j < 0 {
+		return j
+	}
+
+	formatUint64(dst, uint64(-i))
+	dst[j] = '-'
+
+	return j
+}
+
+// CopyInt32Backward copies the base10 representation of an int32 into dst right-aligned,
+// i.e. with the last digit at the end of dst. Negative values get prefixed with '-'.
+//
+// Returns the index in dst of the first byte. If dst is too short, nothing gets copied
+// and the returned index is negative, by the number of bytes missing.
+func CopyInt32Backward(dst []byte, i int32) int {
+	if i >= 0 {
+		return CopyUint32Backward(dst, uint32(i))
+	}
+
+	j := len(dst) - DecimalLenInt32(i)
+	if j < 0 {
+		return j
+	}
+
+	formatUint32(dst, uint32(-i))
+	dst[j] = '-'
+
+	return j
+}
+
+// CopyInt16Backward copies the base10 representation of an int16 into dst right-aligned,
+// i.e. with the last digit at the end of dst. Negative values get prefixed with '-'.
+//
+// Returns the index in dst of the first byte. If dst is too short, nothing gets copied
+// and the returned index is negative, by the number of bytes missing.
+func CopyInt16Backward(dst []byte, i int16) int {
+	if i >= 0 {
+		return CopyUint16Backward(dst, uint16(i))
+	}
+
+	j := len(dst) - DecimalLenInt16(i)
+	if j < 0 {
+		return j
+	}
+
+	formatUint16(dst, uint16(-i))
+	dst[j] = '-'
+
+	return j
+}
+
+// CopyInt8Backward copies the base10 representation of an int8 into dst right-aligned,
+// i.e. with the last digit at the end of dst. Negative values get prefixed with '-'.
+//
+// Returns the index in dst of the first byte. If dst is too short, nothing gets copied
+// and the returned index is negative, by the number of bytes missing.
+func CopyInt8Backward(dst []byte, i int8) int {
+	if i >= 0 {
+		return CopyUint8Backward(dst, uint8(i))
+	}
+
+	j := len(dst) - DecimalLenInt8(i)
+	if j < 0 {
+		return j
+	}
+
+	formatUint16(dst, uint16(uint8(-i)))
+	dst[j] = '-'
+
+	return j
+}
+
+// AppendInt64 appends the base10 representation of an int64 to dst and returns the extended
+// buffer, growing it if necessary. Negative values get prefixed with '-'.
+//
+// Works like strconv.AppendInt(dst, i, 10).
+func AppendInt64(dst []byte, i int64) []byte {
+	dst = grow(dst, uint64Digits+1)
+	n := len(dst)
+
+	return dst[:n+CopyInt64(dst[n:n+uint64Digits+1], i)]
+}
+
+// AppendInt32 appends the base10 representation of an int32 to dst and returns the extended
+// buffer, growing it if necessary. Negative values get prefixed with '-'.
+//
+// Works like strconv.AppendInt(dst, int64(i), 10).
+func AppendInt32(dst []byte, i int32) []byte {
+	dst = grow(dst, uint32Digits+1)
+	n := len(dst)
+
+	return dst[:n+CopyInt32(dst[n:n+uint32Digits+1], i)]
+}
+
+// AppendInt16 appends the base10 representation of an int16 to dst and returns the extended
+// buffer, growing it if necessary. Negative values get prefixed with '-'.
+//
+// Works like strconv.AppendInt(dst, int64(i), 10).
+func AppendInt16(dst []byte, i int16) []byte {
+	dst = grow(dst, uint16Digits+1)
+	n := len(dst)
+
+	return dst[:n+CopyInt16(dst[n:n+uint16Digits+1], i)]
+}
+
+// AppendInt8 appends the base10 representation of an int8 to dst and returns the extended
+// buffer, growing it if necessary. Negative values get prefixed with '-'.
+//
+// Works like strconv.AppendInt(dst, int64(i), 10).
+func AppendInt8(dst []byte, i int8) []byte {
+	dst = grow(dst, uint8Digits+1)
+	n := len(dst)
+
+	return dst[:n+CopyInt8(dst[n:n+uint8Digits+1], i)]
+}
@@ -0,0 +1,254 @@
+package chars
+
+import (
+	"bytes"
+	"strconv"
+	"testing"
+)
+
+func TestCopyInt64(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		in          int64
+		expected    []byte
+		expectedLen int
+	}{
+		{"zero", 0, []byte("0"), 1},
+		{"digit", -9, []byte("-9"), 2},
+		{"mid", -922337203, []byte("-922337203"), 10},
+		{"min", int64Min, []byte("-9223372036854775808"), 20},
+		{"max", int64Max, []byte("9223372036854775807"), 19},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := make([]byte, 20)
+			actualLen := CopyInt64(buf, c.in)
+
+			if actualLen != c.expectedLen {
+				t.Errorf("expected [%d], got [%d]", c.expectedLen, actualLen)
+			}
+
+			if !bytes.Equal(buf[:actualLen], c.expected) {
+				t.Errorf("expected [%s], got [%s]", c.expected, buf[:actualLen])
+			}
+		})
+	}
+}
+
+func TestCopyInt32(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		in          int32
+		expected    []byte
+		expectedLen int
+	}{
+		{"zero", 0, []byte("0"), 1},
+		{"mid", -429496, []byte("-429496"), 7},
+		{"min", int32Min, []byte("-2147483648"), 11},
+		{"max", int32Max, []byte("2147483647"), 10},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := make([]byte, 20)
+			actualLen := CopyInt32(buf, c.in)
+
+			if actualLen != c.expectedLen {
+				t.Errorf("expected [%d], got [%d]", c.expectedLen, actualLen)
+			}
+
+			if !bytes.Equal(buf[:actualLen], c.expected) {
+				t.Errorf("expected [%s], got [%s]", c.expected, buf[:actualLen])
+			}
+		})
+	}
+}
+
+func TestCopyInt16(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		in          int16
+		expected    []byte
+		expectedLen int
+	}{
+		{"zero", 0, []byte("0"), 1},
+		{"mid", -655, []byte("-655"), 4},
+		{"min", int16Min, []byte("-32768"), 6},
+		{"max", int16Max, []byte("32767"), 5},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := make([]byte, 20)
+			actualLen := CopyInt16(buf, c.in)
+
+			if actualLen != c.expectedLen {
+				t.Errorf("expected [%d], got [%d]", c.expectedLen, actualLen)
+			}
+
+			if !bytes.Equal(buf[:actualLen], c.expected) {
+				t.Errorf("expected [%s], got [%s]", c.expected, buf[:actualLen])
+			}
+		})
+	}
+}
+
+func TestCopyInt8(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		in          int8
+		expected    []byte
+		expectedLen int
+	}{
+		{"zero", 0, []byte("0"), 1},
+		{"mid", -25, []byte("-25"), 3},
+		{"min", int8Min, []byte("-128"), 4},
+		{"max", int8Max, []byte("127"), 3},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := make([]byte, 20)
+			actualLen := CopyInt8(buf, c.in)
+
+			if actualLen != c.expectedLen {
+				t.Errorf("expected [%d], got [%d]", c.expectedLen, actualLen)
+			}
+
+			if !bytes.Equal(buf[:actualLen], c.expected) {
+				t.Errorf("expected [%s], got [%s]", c.expected, buf[:actualLen])
+			}
+		})
+	}
+}
+
+func TestAppendInt(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		in   int64
+	}{
+		{"zero", 0},
+		{"digit", -9},
+		{"i8min", int8Min},
+		{"i8max", int8Max},
+		{"i16min", int16Min},
+		{"i32min", int32Min},
+		{"i32max", int32Max},
+		{"i64min", int64Min},
+		{"i64max", int64Max},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix := []byte("n=")
+			expected := strconv.AppendInt(prefix, c.in, 10)
+
+			if actual := AppendInt64(prefix, c.in); !bytes.Equal(actual, expected) {
+				t.Errorf("expected [%s], got [%s]", expected, actual)
+			}
+
+			if c.in >= int32Min && c.in <= int32Max {
+				if actual := AppendInt32(prefix, int32(c.in)); !bytes.Equal(actual, expected) {
+					t.Errorf("expected [%s], got [%s]", expected, actual)
+				}
+			}
+
+			if c.in >= int16Min && c.in <= int16Max {
+				if actual := AppendInt16(prefix, int16(c.in)); !bytes.Equal(actual, expected) {
+					t.Errorf("expected [%s], got [%s]", expected, actual)
+				}
+			}
+
+			if c.in >= int8Min && c.in <= int8Max {
+				if actual := AppendInt8(prefix, int8(c.in)); !bytes.Equal(actual, expected) {
+					t.Errorf("expected [%s], got [%s]", expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestCopyIntTruncated(t *testing.T) {
+	buf := make([]byte, 3)
+
+	if n := CopyInt64(buf[:0], -1); n != 0 {
+		t.Errorf("expected [0], got [%d]", n)
+	}
+
+	if n := CopyInt64(buf[:1], -12); n != 1 || buf[0] != '-' {
+		t.Errorf("expected [-], got [%s]", buf[:n])
+	}
+
+	if n := CopyInt8(buf, int8Min); n != 3 || string(buf) != "-12" {
+		t.Errorf("expected [-12], got [%s]", buf[:n])
+	}
+}
+
+func BenchmarkStrconvAppendInt64(b *testing.B) {
+	by := make([]byte, 0, 20)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendInt(by, int64Min, 10)
+	}
+}
+
+func BenchmarkRushCopyInt64(b *testing.B) {
+	by := make([]byte, 20)
+	for n := 0; n < b.N; n++ {
+		_ = CopyInt64(by, int64Min)
+	}
+}
+
+func BenchmarkStrconvAppendInt32(b *testing.B) {
+	by := make([]byte, 0, 11)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendInt(by, int32Min, 10)
+	}
+}
+
+func BenchmarkRushCopyInt32(b *testing.B) {
+	by := make([]byte, 11)
+	for n := 0; n < b.N; n++ {
+		_ = CopyInt32(by, int32Min)
+	}
+}
+
+func BenchmarkStrconvAppendInt16(b *testing.B) {
+	by := make([]byte, 0, 6)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendInt(by, int16Min, 10)
+	}
+}
+
+func BenchmarkRushCopyInt16(b *testing.B) {
+	by := make([]byte, 6)
+	for n := 0; n < b.N; n++ {
+		_ = CopyInt16(by, int16Min)
+	}
+}
+
+func BenchmarkStrconvAppendInt8(b *testing.B) {
+	by := make([]byte, 0, 4)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendInt(by, int8Min, 10)
+	}
+}
+
+func BenchmarkRushCopyInt8(b *testing.B) {
+	by := make([]byte, 4)
+	for n := 0; n < b.N; n++ {
+		_ = CopyInt8(by, int8Min)
+	}
+}
+
+func BenchmarkRushAppendInt64(b *testing.B) {
+	by := make([]byte, 0, 21)
+	for n := 0; n < b.N; n++ {
+		_ = AppendInt64(by, int64Min)
+	}
+}
@@ -0,0 +1,136 @@
+package chars
+
+import "strings"
+
+// Go-style integer literals, e.g. 0x_FF, 0o755, 0b1010 or 1_000_000.
+//
+// The prefix decides about the base, after which the digits get handed to ParseUint64Base.
+// Inputs containing '_' separators get validated and compacted into a stack buffer first,
+// so that the digit loops themselves never have to deal with them.
+
+// ParseUint64Literal takes an unsigned integer literal following the syntax of Go's integer
+// literals and converts it to an unsigned 64-bit integer. Returns the base used alongside.
+//
+// The base is derived from the prefix: 0x or 0X for 16, 0o or 0O for 8, 0b or 0B for 2, a
+// leading 0 for 8 (legacy octal), otherwise 10. Digits may be separated by single '_'
+// characters, which may also follow the prefix ("0x_FF"), but neither start nor end the
+// literal nor follow each other. Accepts exactly the inputs strconv.ParseUint(s, 0, 64) accepts.
+//
+// If the value overflows, uint64Max and false get returned.
+// If the string is not a valid literal, 0 and false get returned.
+func ParseUint64Literal(s string) (u uint64, base int, ok bool) {
+	base = 10
+	digits := s
+
+	// The prefix counts as a digit as far as separators are concerned.
+	prefixed := false
+	if len(s) > 1 && s[0] == '0' {
+		prefixed = true
+
+		// Lowercase ASCII letters by setting 0x20, which leaves digits untouched.
+		switch s[1] | 0x20 {
+		case 'x':
+			base, digits = 16, s[2:]
+		case 'o':
+			base, digits = 8, s[2:]
+		case 'b':
+			base, digits = 2, s[2:]
+		default:
+			base, digits = 8, s[1:]
+		}
+	}
+
+	if strings.IndexByte(digits, '_') < 0 {
+		u, ok = ParseUint64Base(digits, base)
+		return u, base, ok
+	}
+
+	var (
+		buf  [radixMaxDigits]byte
+		n    int
+		sepd = !prefixed // Whether the last character was a separator (or the start).
+	)
+
+	for i := 0; i < len(digits); i++ {
+		if digits[i] == '_' {
+			if sepd {
+				return 0, base, false
+			}
+
+			sepd = true
+			continue
+		}
+
+		// Overflow is decided by length alone, as in ParseUint64Base. Anything not a digit
+		// gets rejected by it as well.
+		if n == len(buf) {
+			return uint64Max, base, false
+		}
+
+		// Leading zeros get overwritten by the next digit, so that they can't overflow buf.
+		buf[n] = digits[i]
+		if n > 0 || digits[i] != '0' {
+			n++
+		}
+
+		sepd = false
+	}
+
+	if sepd {
+		return 0, base, false
+	}
+
+	// Everything got skipped as leading zeros.
+	if n == 0 {
+		return 0, base, true
+	}
+
+	u, ok = ParseUint64Base(bytesToString(buf[:n]), base)
+
+	return u, base, ok
+}
+
+// ParseInt64Literal takes a signed integer literal with an optional leading '-' or '+' sign,
+// followed by a literal as accepted by ParseUint64Literal, and converts it to a signed 64-bit
+// integer. Returns the base used alongside.
+//
+// Accepts exactly the inputs strconv.ParseInt(s, 0, 64) accepts.
+// If the value overflows, int64Min or int64Max (depending on the sign) and false get returned.
+// If the string is not a valid literal, 0 and false get returned.
+func ParseInt64Literal(s string) (i int64, base int, ok bool) {
+	if len(s) == 0 {
+		return 0, 10, false
+	}
+
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	u, base, ok := ParseUint64Literal(s)
+	if !ok {
+		if u == 0 {
+			return 0, base, false
+		}
+
+		u = uint64Max
+	}
+
+	if neg {
+		if u > -int64Min {
+			return int64Min, base, false
+		}
+
+		return -int64(u), base, true
+	}
+
+	if u > int64Max {
+		return int64Max, base, false
+	}
+
+	return int64(u), base, true
+}
@@ -0,0 +1,137 @@
+package chars
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseUint64Literal(t *testing.T) {
+	for _, c := range []struct {
+		name         string
+		in           string
+		expected     uint64
+		expectedBase int
+		expectedOk   bool
+	}{
+		{"empty", "", 0, 10, false},
+		{"zero", "0", 0, 10, true},
+		{"decimal", "1234", 1234, 10, true},
+		{"decimal-sep", "1_000_000", 1000000, 10, true},
+		{"decimal-max", "18_446_744_073_709_551_615", uint64Max, 10, true},
+		{"hex", "0xFF", 0xFF, 16, true},
+		{"hex-upper-prefix", "0XfF", 0xFF, 16, true},
+		{"hex-sep-prefix", "0x_FF", 0xFF, 16, true},
+		{"hex-sep", "0xdead_beef", 0xDEADBEEF, 16, true},
+		{"octal", "0o755", 0o755, 8, true},
+		{"octal-upper-prefix", "0O7", 7, 8, true},
+		{"octal-legacy", "0755", 0o755, 8, true},
+		{"octal-legacy-zero", "00", 0, 8, true},
+		{"octal-legacy-sep", "0_7", 7, 8, true},
+		{"binary", "0b1010", 10, 2, true},
+		{"binary-sep", "0b_1111_0000", 0xF0, 2, true},
+		{"hex-sep-leading-zeros", "0x_" + strings.Repeat("0", 70) + "1", 1, 16, true},
+		{"octal-legacy-sep-leading-zeros", "0_" + strings.Repeat("0", 70) + "1", 1, 8, true},
+		{"binary-sep-leading-zeros-max", "0b0000000000_" + strings.Repeat("1", 64), uint64Max, 2, true},
+		{"binary-sep-zeros", "0b_0000_0000", 0, 2, true},
+		{"hex-leading-zeros", "0x" + strings.Repeat("0", 24) + "ff", 0xFF, 16, true},
+		{"decimal-leading-zeros-sep", "1" + strings.Repeat("_000", 6), 1e18, 10, true},
+		{"octal-legacy-leading-zeros", strings.Repeat("0", 30) + "17", 0o17, 8, true},
+		{"overflow", "18446744073709551616", uint64Max, 10, false},
+		{"overflow-sep", "18_446_744_073_709_551_616", uint64Max, 10, false},
+		{"overflow-hex", "0x1_0000_0000_0000_0000", uint64Max, 16, false},
+		{"syntax-prefix-only", "0x", 0, 16, false},
+		{"syntax-prefix-sep-only", "0x_", 0, 16, false},
+		{"syntax-sep-lead", "_1", 0, 10, false},
+		{"syntax-sep-trail", "1_", 0, 10, false},
+		{"syntax-sep-double", "1__0", 0, 10, false},
+		{"syntax-sep-after-sep-prefix", "0x__1", 0, 16, false},
+		{"syntax-legacy-digit", "08", 0, 8, false},
+		{"syntax-binary-digit", "0b102", 0, 2, false},
+		{"syntax-hex-digit", "0xfg", 0, 16, false},
+		{"syntax-sign", "-1", 0, 10, false},
+		{"syntax-sep-letter", "1_x", 0, 10, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualBase, actualOk := ParseUint64Literal(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualBase != c.expectedBase {
+				t.Errorf("expected base [%d], got [%d]", c.expectedBase, actualBase)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+
+			// Acceptance must match strconv's.
+			if _, err := strconv.ParseUint(c.in, 0, 64); (err == nil) != actualOk {
+				t.Errorf("strconv disagrees: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseInt64Literal(t *testing.T) {
+	for _, c := range []struct {
+		name         string
+		in           string
+		expected     int64
+		expectedBase int
+		expectedOk   bool
+	}{
+		{"empty", "", 0, 10, false},
+		{"sign-only", "-", 0, 10, false},
+		{"neg-hex", "-0x80", -128, 16, true},
+		{"plus-octal", "+0o17", 15, 8, true},
+		{"neg-sep", "-1_000", -1000, 10, true},
+		{"neg-sep-leading-zeros", "-0x_" + strings.Repeat("0", 70) + "1", -1, 16, true},
+		{"min", "-0x8000_0000_0000_0000", int64Min, 16, true},
+		{"max", "0x7fff_ffff_ffff_ffff", int64Max, 16, true},
+		{"overflow-min", "-0x8000_0000_0000_0001", int64Min, 16, false},
+		{"overflow-max", "0x8000_0000_0000_0000", int64Max, 16, false},
+		{"syntax", "-0b2", 0, 2, false},
+		{"syntax-double-sign", "--1", 0, 10, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualBase, actualOk := ParseInt64Literal(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualBase != c.expectedBase {
+				t.Errorf("expected base [%d], got [%d]", c.expectedBase, actualBase)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+
+			if _, err := strconv.ParseInt(c.in, 0, 64); (err == nil) != actualOk {
+				t.Errorf("strconv disagrees: %v", err)
+			}
+		})
+	}
+}
+
+func BenchmarkStrconvParseUint64Literal(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseUint("0xdead_beef", 0, 64)
+	}
+}
+
+func BenchmarkRushParseUint64Literal(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _, _ = ParseUint64Literal("0xdead_beef")
+	}
+}
@@ -0,0 +1,175 @@
+package chars
+
+// Fixed-width formatting.
+//
+// The Padded variants always produce a field of exactly width bytes, left-padded with fill
+// (usually '0' or ' '). If the value needs more digits than width, only its width least
+// significant digits get written and false gets returned - the field width takes precedence
+// over the value, since breaking the layout of a fixed-width record tends to be worse.
+//
+// As with the other Copy functions, the field gets copied into dst up to len(dst),
+// discarding all overflowing bytes - but since a partially written field breaks the layout
+// just as well, false gets returned in that case, too.
+
+// CopyUint64Padded copies the base10 representation of a uint64, left-padded with fill to
+// width bytes, into dst.
+//
+// Returns the number of bytes copied to dst and false if the value had to be truncated
+// to fit width or dst is shorter than width.
+func CopyUint64Padded(dst []byte, u uint64, width int, fill byte) (int, bool) {
+	var b [uint64Digits]byte
+	return copyPadded(dst, "", b[:CopyUint64(b[:], u)], width, fill)
+}
+
+// CopyUint32Padded copies the base10 representation of a uint32, left-padded with fill to
+// width bytes, into dst.
+//
+// Returns the number of bytes copied to dst and false if the value had to be truncated
+// to fit width or dst is shorter than width.
+func CopyUint32Padded(dst []byte, u uint32, width int, fill byte) (int, bool) {
+	var b [uint32Digits]byte
+	return copyPadded(dst, "", b[:CopyUint32(b[:], u)], width, fill)
+}
+
+// CopyUint16Padded copies the base10 representation of a uint16, left-padded with fill to
+// width bytes, into dst.
+//
+// Returns the number of bytes copied to dst and false if the value had to be truncated
+// to fit width or dst is shorter than width.
+func CopyUint16Padded(dst []byte, u uint16, width int, fill byte) (int, bool) {
+	var b [uint16Digits]byte
+	return copyPadded(dst, "", b[:CopyUint16(b[:], u)], width, fill)
+}
+
+// CopyUint8Padded copies the base10 representation of a uint8, left-padded with fill to
+// width bytes, into dst.
+//
+// Returns the number of bytes copied to dst and false if the value had to be truncated
+// to fit width or dst is shorter than width.
+func CopyUint8Padded(dst []byte, u uint8, width int, fill byte) (int, bool) {
+	var b [uint8Digits]byte
+	return copyPadded(dst, "", b[:CopyUint8(b[:], u)], width, fill)
+}
+
+// CopyInt64Padded copies the base10 representation of an int64, left-padded with fill to
+// width bytes, into dst. The width includes the '-' sign of negative values.
+//
+// When fill is '0', the padding goes between the sign and the digits ("-007"), otherwise
+// in front of the sign ("  -7"). A truncated negative value keeps its sign.
+//
+// Returns the number of bytes copied to dst and false if the value had to be truncated
+// to fit width or dst is shorter than width.
+func CopyInt64Padded(dst []byte, i int64, width int, fill byte) (int, bool) {
+	var b [uint64Digits]byte
+	if i >= 0 {
+		return copyPadded(dst, "", b[:CopyUint64(b[:], uint64(i))], width, fill)
+	}
+
+	return copyPadded(dst, "-", b[:CopyUint64(b[:], uint64(-i))], width, fill)
+}
+
+// CopyInt32Padded copies the base10 representation of an int32, left-padded with fill to
+// width bytes, into dst. The width includes the '-' sign of negative values.
+//
+// The sign gets placed like in CopyInt64Padded.
+//
+// Returns the number of bytes copied to dst and false if the value had to be truncated
+// to fit width or dst is shorter than width.
+func CopyInt32Padded(dst []byte, i int32, width int, fill byte) (int, bool) {
+	var b [uint32Digits]byte
+	if i >= 0 {
+		return copyPadded(dst, "", b[:CopyUint32(b[:], uint32(i))], width, fill)
+	}
+
+	return copyPadded(dst, "-", b[:CopyUint32(b[:], uint32(-i))], width, fill)
+}
+
+// CopyInt16Padded copies the base10 representation of an int16, left-padded with fill to
+// width bytes, into dst. The width includes the '-' sign of negative values.
+//
+// The sign gets placed like in CopyInt64Padded.
+//
+// Returns the number of bytes copied to dst and false if the value had to be truncated
+// to fit width or dst is shorter than width.
+func CopyInt16Padded(dst []byte, i int16, width int, fill byte) (int, bool) {
+	var b [uint16Digits]byte
+	if i >= 0 {
+		return copyPadded(dst, "", b[:CopyUint16(b[:], uint16(i))], width, fill)
+	}
+
+	return copyPadded(dst, "-", b[:CopyUint16(b[:], uint16(-i))], width, fill)
+}
+
+// CopyInt8Padded copies the base10 representation of an int8, left-padded with fill to
+// width bytes, into dst. The width includes the '-' sign of negative values.
+//
+// The sign gets placed like in CopyInt64Padded.
+//
+// Returns the number of bytes copied to dst and false if the value had to be truncated
+// to fit width or dst is shorter than width.
+func CopyInt8Padded(dst []byte, i int8, width int, fill byte) (int, bool) {
+	var b [uint8Digits]byte
+	if i >= 0 {
+		return copyPadded(dst, "", b[:CopyUint8(b[:], uint8(i))], width, fill)
+	}
+
+	return copyPadded(dst, "-", b[:CopyUint8(b[:], uint8(-i))], width, fill)
+}
+
+// copyPadded assembles a field of width bytes out of the sign (empty or "-"), the fill bytes and
+// the digits, and copies it into dst up to len(dst).
+func copyPadded(dst []byte, sign string, digits []byte, width int, fill byte) (int, bool) {
+	if width <= 0 {
+		return 0, false
+	}
+
+	ok := true
+	if room := width - len(sign); len(digits) > room {
+		ok = false
+
+		if room < 0 {
+			// Not even the sign fits - the width of the field still wins.
+			sign, room = sign[:width], 0
+		}
+
+		digits = digits[len(digits)-room:]
+	}
+
+	var (
+		n   int
+		pad = width - len(sign) - len(digits)
+	)
+
+	if width > len(dst) {
+		width = len(dst)
+		ok = false
+	}
+
+	dst = dst[:width]
+
+	// Zero padding goes after the sign, anything else in front of it.
+	if fill != '0' {
+		n += fillBytes(dst, fill, pad)
+		n += copy(dst[n:], sign)
+	} else {
+		n += copy(dst, sign)
+		n += fillBytes(dst[n:], fill, pad)
+	}
+
+	return n + copy(dst[n:], digits), ok
+}
+
+// fillBytes sets up to n bytes at the start of dst to c.
+//
+// Returns the number of bytes set.
+func fillBytes(dst []byte, c byte, n int) int {
+	if n > len(dst) {
+		n = len(dst)
+	}
+
+	for i := 0; i < n; i++ {
+		dst[i] = c
+	}
+
+	return n
+}
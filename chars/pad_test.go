@@ -0,0 +1,122 @@
+package chars
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestCopyUint64Padded(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         uint64
+		width      int
+		fill       byte
+		expected   []byte
+		expectedOk bool
+	}{
+		{"seq", 7, 4, '0', []byte("0007"), true},
+		{"minutes", 9, 2, '0', []byte("09"), true},
+		{"exact", 123, 3, '0', []byte("123"), true},
+		{"spaces", 42, 5, ' ', []byte("   42"), true},
+		{"max", u64max, 24, '0', []byte("000018446744073709551615"), true},
+		{"truncated", 1234, 3, '0', []byte("234"), false},
+		{"zero-width", 1, 0, '0', []byte(""), false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := make([]byte, 32)
+			actualLen, actualOk := CopyUint64Padded(buf, c.in, c.width, c.fill)
+
+			if !bytes.Equal(buf[:actualLen], c.expected) {
+				t.Errorf("expected [%s], got [%s]", c.expected, buf[:actualLen])
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestCopyInt64Padded(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         int64
+		width      int
+		fill       byte
+		expected   []byte
+		expectedOk bool
+	}{
+		{"pos", 7, 4, '0', []byte("0007"), true},
+		{"neg-zeros", -7, 4, '0', []byte("-007"), true},
+		{"neg-spaces", -7, 4, ' ', []byte("  -7"), true},
+		{"neg-exact", -123, 4, '0', []byte("-123"), true},
+		{"min", int64Min, 21, '0', []byte("-09223372036854775808"), true},
+		{"neg-truncated", -12345, 3, '0', []byte("-45"), false},
+		{"sign-only", -1, 1, '0', []byte("-"), false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			buf := make([]byte, 32)
+			actualLen, actualOk := CopyInt64Padded(buf, c.in, c.width, c.fill)
+
+			if !bytes.Equal(buf[:actualLen], c.expected) {
+				t.Errorf("expected [%s], got [%s]", c.expected, buf[:actualLen])
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestCopyPaddedWidths(t *testing.T) {
+	buf := make([]byte, 8)
+
+	if n, ok := CopyUint32Padded(buf, 42, 6, '0'); string(buf[:n]) != "000042" || !ok {
+		t.Errorf("expected [000042, true], got [%s, %t]", buf[:n], ok)
+	}
+
+	if n, ok := CopyUint16Padded(buf, u16max, 4, '0'); string(buf[:n]) != "5535" || ok {
+		t.Errorf("expected [5535, false], got [%s, %t]", buf[:n], ok)
+	}
+
+	// FIX checksum.
+	if n, ok := CopyUint8Padded(buf, 7, 3, '0'); string(buf[:n]) != "007" || !ok {
+		t.Errorf("expected [007, true], got [%s, %t]", buf[:n], ok)
+	}
+
+	if n, ok := CopyInt32Padded(buf, -42, 6, '0'); string(buf[:n]) != "-00042" || !ok {
+		t.Errorf("expected [-00042, true], got [%s, %t]", buf[:n], ok)
+	}
+
+	if n, ok := CopyInt16Padded(buf, int16Min, 8, ' '); string(buf[:n]) != "  -32768" || !ok {
+		t.Errorf("expected [  -32768, true], got [%s, %t]", buf[:n], ok)
+	}
+
+	if n, ok := CopyInt8Padded(buf, -5, 3, '0'); string(buf[:n]) != "-05" || !ok {
+		t.Errorf("expected [-05, true], got [%s, %t]", buf[:n], ok)
+	}
+
+	// Short dst discards the overflowing bytes, like the other Copy functions, but reports
+	// the incomplete field.
+	if n, ok := CopyUint64Padded(buf[:2], 7, 4, '0'); string(buf[:n]) != "00" || ok {
+		t.Errorf("expected [00, false], got [%s, %t]", buf[:n], ok)
+	}
+
+	if n, ok := CopyInt64Padded(buf[:3], -7, 3, ' '); string(buf[:n]) != " -7" || !ok {
+		t.Errorf("expected [ -7, true], got [%s, %t]", buf[:n], ok)
+	}
+}
+
+func BenchmarkRushCopyUint64Padded(b *testing.B) {
+	by := make([]byte, 24)
+	for n := 0; n < b.N; n++ {
+		_, _ = CopyUint64Padded(by, u64mid, 24, '0')
+	}
+}
@@ -0,0 +1,322 @@
+package chars
+
+import "strings"
+
+// Configurable decimal parsing.
+//
+// A Parser strips whatever its options permit - surrounding whitespace, the sign and '_'
+// separators - off the input first and then hands the remaining digits to the typed parser of
+// the target width, which takes care of leading zeros. The zero value (strict) has nothing to
+// strip and hands the input to the typed parser right away, after checking for a '+' sign or
+// a leading zero.
+
+// Parser parses base10 (decimal) integers with the leniencies enabled by its option flags.
+//
+// The zero value is strict: it accepts a '-' sign for signed types only, and rejects leading
+// '+' signs, surrounding whitespace, separators and leading zeros ("0" itself excepted) - as
+// canonical JSON or HTTP's Content-Length do.
+type Parser uint8
+
+const (
+	// AllowPlus accepts a leading '+' sign, for unsigned types as well.
+	AllowPlus Parser = 1 << iota
+
+	// AllowSpace accepts leading and trailing ASCII whitespace (' ', '\t', '\n', '\v', '\f', '\r').
+	AllowSpace
+
+	// AllowUnderscores accepts single '_' characters between digits, e.g. "1_000_000".
+	AllowUnderscores
+
+	// AllowLeadingZeros accepts any number of leading zeros, as the plain parsers do.
+	AllowLeadingZeros
+)
+
+// Stands in for the digits of inputs too long to be compacted into the buffer of digits,
+// overflowing all widths by its length alone.
+const parserOverflow = "999999999999999999999"
+
+// ParseUint64 works like the package-level ParseUint64, subject to the options of p.
+func (p Parser) ParseUint64(s string) (uint64, bool) {
+	if p == 0 {
+		if !strict(s) {
+			return 0, false
+		}
+
+		return ParseUint64(s)
+	}
+
+	var buf [uint64Digits]byte
+	d, neg, ok := p.digits(s, &buf)
+	if !ok || neg {
+		return 0, false
+	}
+
+	return ParseUint64(d)
+}
+
+// ParseUint32 works like the package-level ParseUint32, subject to the options of p.
+func (p Parser) ParseUint32(s string) (uint32, bool) {
+	if p == 0 {
+		if !strict(s) {
+			return 0, false
+		}
+
+		return ParseUint32(s)
+	}
+
+	var buf [uint64Digits]byte
+	d, neg, ok := p.digits(s, &buf)
+	if !ok || neg {
+		return 0, false
+	}
+
+	return ParseUint32(d)
+}
+
+// ParseUint16 works like the package-level ParseUint16, subject to the options of p.
+func (p Parser) ParseUint16(s string) (uint16, bool) {
+	if p == 0 {
+		if !strict(s) {
+			return 0, false
+		}
+
+		return ParseUint16(s)
+	}
+
+	var buf [uint64Digits]byte
+	d, neg, ok := p.digits(s, &buf)
+	if !ok || neg {
+		return 0, false
+	}
+
+	return ParseUint16(d)
+}
+
+// ParseUint8 works like the package-level ParseUint8, subject to the options of p.
+func (p Parser) ParseUint8(s string) (uint8, bool) {
+	if p == 0 {
+		if !strict(s) {
+			return 0, false
+		}
+
+		return ParseUint8(s)
+	}
+
+	var buf [uint64Digits]byte
+	d, neg, ok := p.digits(s, &buf)
+	if !ok || neg {
+		return 0, false
+	}
+
+	return ParseUint8(d)
+}
+
+// ParseInt64 works like the package-level ParseInt64, subject to the options of p.
+func (p Parser) ParseInt64(s string) (int64, bool) {
+	if p == 0 {
+		if !strict(s) {
+			return 0, false
+		}
+
+		return ParseInt64(s)
+	}
+
+	var buf [uint64Digits]byte
+	d, neg, ok := p.digits(s, &buf)
+	if !ok {
+		return 0, false
+	}
+
+	u, ok := ParseUint64(d)
+	return signedRange(u, ok, neg, int64Min, int64Max)
+}
+
+// ParseInt32 works like the package-level ParseInt32, subject to the options of p.
+func (p Parser) ParseInt32(s string) (int32, bool) {
+	if p == 0 {
+		if !strict(s) {
+			return 0, false
+		}
+
+		return ParseInt32(s)
+	}
+
+	var buf [uint64Digits]byte
+	d, neg, ok := p.digits(s, &buf)
+	if !ok {
+		return 0, false
+	}
+
+	u, ok := ParseUint32(d)
+	i, ok := signedRange(uint64(u), ok, neg, int32Min, int32Max)
+	return int32(i), ok
+}
+
+// ParseInt16 works like the package-level ParseInt16, subject to the options of p.
+func (p Parser) ParseInt16(s string) (int16, bool) {
+	if p == 0 {
+		if !strict(s) {
+			return 0, false
+		}
+
+		return ParseInt16(s)
+	}
+
+	var buf [uint64Digits]byte
+	d, neg, ok := p.digits(s, &buf)
+	if !ok {
+		return 0, false
+	}
+
+	u, ok := ParseUint16(d)
+	i, ok := signedRange(uint64(u), ok, neg, int16Min, int16Max)
+	return int16(i), ok
+}
+
+// ParseInt8 works like the package-level ParseInt8, subject to the options of p.
+func (p Parser) ParseInt8(s string) (int8, bool) {
+	if p == 0 {
+		if !strict(s) {
+			return 0, false
+		}
+
+		return ParseInt8(s)
+	}
+
+	var buf [uint64Digits]byte
+	d, neg, ok := p.digits(s, &buf)
+	if !ok {
+		return 0, false
+	}
+
+	u, ok := ParseUint8(d)
+	i, ok := signedRange(uint64(u), ok, neg, int8Min, int8Max)
+	return int8(i), ok
+}
+
+// digits strips everything the options of p permit off s and returns the remaining digits
+// (which may still contain invalid characters, left for the typed parsers to reject) and
+// whether a '-' sign got stripped. Separators get compacted into buf, which the result may
+// share memory with.
+//
+// Returns false if s is malformed in a way the typed parsers can't detect.
+func (p Parser) digits(s string, buf *[uint64Digits]byte) (d string, neg bool, ok bool) {
+	if p&AllowSpace != 0 {
+		s = trimSpace(s)
+	}
+
+	if len(s) > 0 {
+		switch {
+		case s[0] == '-':
+			neg = true
+			s = s[1:]
+		case s[0] == '+' && p&AllowPlus != 0:
+			s = s[1:]
+		}
+	}
+
+	if len(s) > 1 && s[0] == '0' && p&AllowLeadingZeros == 0 {
+		return "", neg, false
+	}
+
+	if p&AllowUnderscores != 0 && strings.IndexByte(s, '_') >= 0 {
+		if s, ok = compactDigits(s, buf); !ok {
+			return "", neg, false
+		}
+	}
+
+	return s, neg, true
+}
+
+// compactDigits copies s without its '_' separators and leading zeros into buf and returns
+// the result sharing memory with buf. If the result does not fit, parserOverflow gets
+// returned instead.
+//
+// Returns false if a separator does not sit between two non-separators.
+func compactDigits(s string, buf *[uint64Digits]byte) (string, bool) {
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c == '_' {
+			// A separator preceded by another one has already been rejected by the check of
+			// that one, so only the next character needs to be looked at.
+			if i == 0 || i == len(s)-1 || s[i+1] == '_' {
+				return "", false
+			}
+
+			continue
+		}
+
+		// Overflow is decided by length alone, as in the typed parsers.
+		if n == len(buf) {
+			return parserOverflow, true
+		}
+
+		// Leading zeros get overwritten by the next digit, so that they can't overflow buf.
+		buf[n] = c
+		if n > 0 || c != '0' {
+			n++
+		}
+	}
+
+	// Everything got skipped as leading zeros.
+	if n == 0 {
+		return "0", true
+	}
+
+	return bytesToString(buf[:n]), true
+}
+
+// strict reports whether s has neither a '+' sign nor leading zeros - the only things the
+// plain parsers accept that the zero Parser does not.
+func strict(s string) bool {
+	if len(s) > 0 && s[0] == '-' {
+		s = s[1:]
+	}
+
+	return len(s) == 0 || s[0] != '+' && (len(s) == 1 || s[0] != '0')
+}
+
+// signedRange range checks the magnitude u, as returned along with ok by an unsigned parser,
+// against the bounds of a signed type.
+func signedRange(u uint64, ok, neg bool, min, max int64) (int64, bool) {
+	if !ok {
+		if u == 0 {
+			// Syntax error.
+			return 0, false
+		}
+
+		u = uint64Max
+	}
+
+	if neg {
+		if u > uint64(-min) {
+			return min, false
+		}
+
+		return -int64(u), true
+	}
+
+	if u > uint64(max) {
+		return max, false
+	}
+
+	return int64(u), true
+}
+
+// trimSpace returns s without leading and trailing ASCII whitespace.
+func trimSpace(s string) string {
+	for len(s) > 0 && isSpace(s[0]) {
+		s = s[1:]
+	}
+
+	for len(s) > 0 && isSpace(s[len(s)-1]) {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c-'\t' <= '\r'-'\t'
+}
@@ -0,0 +1,173 @@
+package chars
+
+import (
+	"strconv"
+	"testing"
+)
+
+func TestParserParseUint64(t *testing.T) {
+	const all = AllowPlus | AllowSpace | AllowUnderscores | AllowLeadingZeros
+
+	for _, c := range []struct {
+		name       string
+		p          Parser
+		in         string
+		expected   uint64
+		expectedOk bool
+	}{
+		{"strict", 0, "1234", 1234, true},
+		{"strict-zero", 0, "0", 0, true},
+		{"strict-max", 0, "18446744073709551615", uint64Max, true},
+		{"strict-empty", 0, "", 0, false},
+		{"strict-plus", 0, "+1", 0, false},
+		{"strict-minus", 0, "-0", 0, false},
+		{"strict-space", 0, " 1", 0, false},
+		{"strict-underscore", 0, "1_000", 0, false},
+		{"strict-leading-zero", 0, "01", 0, false},
+		{"strict-leading-zeros", 0, "00", 0, false},
+		{"strict-overflow", 0, "18446744073709551616", uint64Max, false},
+		{"plus", AllowPlus, "+1", 1, true},
+		{"plus-only", AllowPlus, "+", 0, false},
+		{"plus-double", AllowPlus, "++1", 0, false},
+		{"plus-minus", AllowPlus, "-1", 0, false},
+		{"space", AllowSpace, " \t1234\r\n", 1234, true},
+		{"space-only", AllowSpace, "  ", 0, false},
+		{"space-inner", AllowSpace, "12 34", 0, false},
+		{"space-after-sign", AllowSpace | AllowPlus, "+ 1", 0, false},
+		{"underscores", AllowUnderscores, "1_000_000", 1000000, true},
+		{"underscores-lead", AllowUnderscores, "_1", 0, false},
+		{"underscores-trail", AllowUnderscores, "1_", 0, false},
+		{"underscores-double", AllowUnderscores, "1__0", 0, false},
+		{"underscores-only", AllowUnderscores, "_", 0, false},
+		{"underscores-leading-zero", AllowUnderscores, "0_1", 0, false},
+		{"underscores-max", AllowUnderscores, "18_446_744_073_709_551_615", uint64Max, true},
+		{"underscores-overflow", AllowUnderscores, "18_446_744_073_709_551_616", uint64Max, false},
+		{"underscores-overflow-length", AllowUnderscores, "1_000_000_000_000_000_000_000", uint64Max, false},
+		{"leading-zeros", AllowLeadingZeros, "0001", 1, true},
+		{"leading-zeros-all", AllowLeadingZeros, "0000", 0, true},
+		{"leading-zeros-max", AllowLeadingZeros, "00000018446744073709551615", uint64Max, true},
+		{"leading-zeros-overflow", AllowLeadingZeros, "00000018446744073709551616", uint64Max, false},
+		{"leading-zeros-syntax", AllowLeadingZeros, "00x", 0, false},
+		{"all", all, " +000_001_234 ", 1234, true},
+		{"all-zeros", all, "0_000", 0, true},
+		{"all-syntax", all, " +1_2a ", 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := c.p.ParseUint64(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParserParseInt64(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		p          Parser
+		in         string
+		expected   int64
+		expectedOk bool
+	}{
+		{"strict-neg", 0, "-1234", -1234, true},
+		{"strict-neg-zero", 0, "-0", 0, true},
+		{"strict-min", 0, "-9223372036854775808", int64Min, true},
+		{"strict-max", 0, "9223372036854775807", int64Max, true},
+		{"strict-plus", 0, "+1", 0, false},
+		{"strict-neg-leading-zero", 0, "-01", 0, false},
+		{"strict-sign-only", 0, "-", 0, false},
+		{"strict-overflow-min", 0, "-9223372036854775809", int64Min, false},
+		{"strict-overflow-max", 0, "9223372036854775808", int64Max, false},
+		{"plus", AllowPlus, "+1", 1, true},
+		{"neg-underscores", AllowUnderscores, "-1_000", -1000, true},
+		{"neg-underscores-lead", AllowUnderscores, "-_1", 0, false},
+		{"neg-leading-zeros", AllowLeadingZeros, "-0009223372036854775808", int64Min, true},
+		{"neg-space", AllowSpace, "\t-42 ", -42, true},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := c.p.ParseInt64(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParserWidths(t *testing.T) {
+	p := AllowPlus | AllowLeadingZeros
+
+	for _, c := range []struct {
+		in string
+		u8 uint8
+		i8 int8
+		ok bool
+	}{
+		{"+0000255", 255, int8Max, false},
+		{"+0000127", 127, 127, true},
+		{"000256", uint8Max, int8Max, false},
+	} {
+		if u, _ := p.ParseUint8(c.in); u != c.u8 {
+			t.Errorf("[%s]: expected [%d], got [%d]", c.in, c.u8, u)
+		}
+
+		if i, ok := p.ParseInt8(c.in); i != c.i8 || ok != c.ok {
+			t.Errorf("[%s]: expected [%d, %t], got [%d, %t]", c.in, c.i8, c.ok, i, ok)
+		}
+	}
+
+	if i, ok := Parser(0).ParseInt8("-128"); i != int8Min || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", int8Min, i, ok)
+	}
+
+	if u, ok := (AllowSpace).ParseUint16(" 65535 "); u != uint16Max || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", uint16Max, u, ok)
+	}
+
+	if i, ok := (AllowUnderscores).ParseInt16("-32_768"); i != int16Min || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", int16Min, i, ok)
+	}
+
+	if u, ok := Parser(0).ParseUint32("4294967296"); u != uint32Max || ok {
+		t.Errorf("expected [%d, false], got [%d, %t]", uint64(uint32Max), u, ok)
+	}
+
+	if i, ok := (AllowPlus).ParseInt32("+2147483647"); i != int32Max || !ok {
+		t.Errorf("expected [%d, true], got [%d, %t]", int32Max, i, ok)
+	}
+}
+
+func BenchmarkStrconvParserParseUint64(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseUint("1_000_000", 0, 64)
+	}
+}
+
+func BenchmarkRushParserParseUint64(b *testing.B) {
+	p := AllowUnderscores
+	for n := 0; n < b.N; n++ {
+		_, _ = p.ParseUint64("1_000_000")
+	}
+}
+
+func BenchmarkRushParserParseUint64Strict(b *testing.B) {
+	var p Parser
+	for n := 0; n < b.N; n++ {
+		_, _ = p.ParseUint64("1000000")
+	}
+}
@@ -0,0 +1,131 @@
+//go:build ignore
+
+// Generates pow10_table.go, the tables of 128-bit mantissas of powers of 10 used by the
+// Eisel-Lemire algorithm in dtof.go and the Schubfach algorithm in ftod.go.
+//
+// Usage: go generate (or go run pow10_gen.go)
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math/big"
+	"os"
+)
+
+const (
+	minExp10 = -348
+	maxExp10 = 347
+
+	// Range of k in Schubfach's 10^-k for float64.
+	schubfachMinK = -324
+	schubfachMaxK = 292
+)
+
+func main() {
+	var (
+		buf bytes.Buffer
+		one = big.NewInt(1)
+		lo  = new(big.Int).Lsh(one, 127)
+		hi  = new(big.Int).Lsh(one, 128)
+		m64 = new(big.Int).Sub(new(big.Int).Lsh(one, 64), one)
+	)
+
+	fmt.Fprintf(&buf, "// Code generated by pow10_gen.go. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&buf, "package chars\n\n")
+	fmt.Fprintf(&buf, "const (\n\tpow10MinExp = %d\n\tpow10MaxExp = %d\n)\n\n", minExp10, maxExp10)
+	fmt.Fprintf(&buf, "// pow10Mantissas holds the 128-bit mantissas of 10^e for e in [pow10MinExp, pow10MaxExp],\n")
+	fmt.Fprintf(&buf, "// normalized so that the most significant bit is set, as {low, high} 64-bit halves.\n")
+	fmt.Fprintf(&buf, "// See pow10_gen.go for how the values get rounded.\n")
+	fmt.Fprintf(&buf, "var pow10Mantissas = [pow10MaxExp - pow10MinExp + 1][2]uint64{\n")
+
+	for e := minExp10; e <= maxExp10; e++ {
+		var m *big.Int
+
+		if e >= 0 {
+			// 10^e and 5^e share the same mantissa. Shift it into [2^127, 2^128), truncating.
+			m = new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(e)), nil)
+			for m.Cmp(lo) < 0 {
+				m.Lsh(m, 1)
+			}
+			for m.Cmp(hi) >= 0 {
+				m.Rsh(m, 1)
+			}
+		} else {
+			// 2^b / 5^-e, plus one. For small exponents, b is chosen so that the quotient has
+			// exactly 128 bits (rounding it up). Otherwise a wider quotient gets truncated to
+			// 128 bits. Same approach as fast_float (and Go's strconv).
+			p := new(big.Int).Exp(big.NewInt(5), big.NewInt(int64(-e)), nil)
+			z := uint(p.BitLen())
+
+			b := 2*z + 128
+			if e >= -27 {
+				b = z + 127
+			}
+
+			m = new(big.Int).Lsh(one, b)
+			m.Quo(m, p)
+			m.Add(m, one)
+			for m.Cmp(hi) >= 0 {
+				m.Rsh(m, 1)
+			}
+		}
+
+		l := new(big.Int).And(m, m64)
+		h := new(big.Int).Rsh(m, 64)
+		fmt.Fprintf(&buf, "\t{0x%016X, 0x%016X}, // 1e%d\n", l.Uint64(), h.Uint64(), e)
+	}
+
+	fmt.Fprintf(&buf, "}\n\n")
+
+	// g = floor(10^-k * 2^-r) + 1, with r chosen so that 2^125 <= g < 2^126, split into its
+	// top 63 bits and bottom 63 bits. The +1 makes g an upper bound of the exact value even
+	// when 10^-k is exactly representable (which the algorithm relies on).
+	fmt.Fprintf(&buf, "const (\n\tschubfachMinK = %d\n\tschubfachMaxK = %d\n)\n\n", schubfachMinK, schubfachMaxK)
+	fmt.Fprintf(&buf, "// schubfachG holds the 126-bit approximations g of 10^-k for k in\n")
+	fmt.Fprintf(&buf, "// [schubfachMinK, schubfachMaxK], as {g1, g0} with g = g1*2^63 + g0.\n")
+	fmt.Fprintf(&buf, "var schubfachG = [schubfachMaxK - schubfachMinK + 1][2]uint64{\n")
+
+	var (
+		g125 = new(big.Int).Lsh(one, 125)
+		g126 = new(big.Int).Lsh(one, 126)
+		m63  = new(big.Int).Sub(new(big.Int).Lsh(one, 63), one)
+	)
+
+	for k := schubfachMinK; k <= schubfachMaxK; k++ {
+		var num, den *big.Int
+
+		if k <= 0 {
+			num, den = new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(-k)), nil), big.NewInt(1)
+		} else {
+			num, den = big.NewInt(1), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(k)), nil)
+		}
+
+		// Scale by powers of 2 until floor(num/den) lands in [2^125, 2^126).
+		for new(big.Int).Quo(num, den).Cmp(g125) < 0 {
+			num.Lsh(num, 1)
+		}
+		for new(big.Int).Quo(num, den).Cmp(g126) >= 0 {
+			den.Lsh(den, 1)
+		}
+
+		g := new(big.Int).Quo(num, den)
+		g.Add(g, one)
+
+		g1 := new(big.Int).Rsh(g, 63)
+		g0 := new(big.Int).And(g, m63)
+		fmt.Fprintf(&buf, "\t{0x%016X, 0x%016X}, // 1e%d\n", g1.Uint64(), g0.Uint64(), -k)
+	}
+
+	fmt.Fprintf(&buf, "}\n")
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		panic(err)
+	}
+
+	if err := os.WriteFile("pow10_table.go", src, 0o644); err != nil {
+		panic(err)
+	}
+}
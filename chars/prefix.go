@@ -0,0 +1,163 @@
+package chars
+
+// Prefix parsing, strtoul-style.
+//
+// Instead of requiring the whole input to be a number, the Prefix variants parse the longest
+// leading run of digits and report how many bytes they consumed, so that they can be used to
+// build tokenizers, e.g. for "123,456" or "200 OK".
+//
+// On overflow, the whole run of digits still gets consumed, the value saturates at the max
+// (or min) of the type and ovf is true. If s does not start with a digit (after the optional
+// sign in case of the signed variants), 0 bytes get consumed and 0 gets returned.
+
+// ParseUint64Prefix parses the longest leading run of base10 digits in s as an unsigned
+// 64-bit integer.
+//
+// Returns the value, the number of bytes consumed and whether the value overflowed.
+func ParseUint64Prefix(s string) (u uint64, n int, ovf bool) {
+	return parseUintPrefix(s, uint64Max)
+}
+
+// ParseUint32Prefix parses the longest leading run of base10 digits in s as an unsigned
+// 32-bit integer.
+//
+// Returns the value, the number of bytes consumed and whether the value overflowed.
+func ParseUint32Prefix(s string) (u uint32, n int, ovf bool) {
+	r, n, ovf := parseUintPrefix(s, uint32Max)
+	return uint32(r), n, ovf
+}
+
+// ParseUint16Prefix parses the longest leading run of base10 digits in s as an unsigned
+// 16-bit integer.
+//
+// Returns the value, the number of bytes consumed and whether the value overflowed.
+func ParseUint16Prefix(s string) (u uint16, n int, ovf bool) {
+	r, n, ovf := parseUintPrefix(s, uint16Max)
+	return uint16(r), n, ovf
+}
+
+// ParseUint8Prefix parses the longest leading run of base10 digits in s as an unsigned
+// 8-bit integer.
+//
+// Returns the value, the number of bytes consumed and whether the value overflowed.
+func ParseUint8Prefix(s string) (u uint8, n int, ovf bool) {
+	r, n, ovf := parseUintPrefix(s, uint8Max)
+	return uint8(r), n, ovf
+}
+
+// ParseInt64Prefix parses an optional leading '-' or '+' sign followed by the longest run
+// of base10 digits in s as a signed 64-bit integer.
+//
+// Returns the value, the number of bytes consumed (including the sign) and whether the value
+// overflowed. A sign not followed by a digit does not get consumed.
+func ParseInt64Prefix(s string) (i int64, n int, ovf bool) {
+	neg, off := parseSign(s)
+	if !neg {
+		u, n, ovf := parseUintPrefix(s[off:], int64Max)
+		return int64(u), consumed(off, n), ovf
+	}
+
+	u, n, ovf := parseUintPrefix(s[off:], -int64Min)
+	return -int64(u), consumed(off, n), ovf
+}
+
+// ParseInt32Prefix parses an optional leading '-' or '+' sign followed by the longest run
+// of base10 digits in s as a signed 32-bit integer.
+//
+// Returns the value, the number of bytes consumed (including the sign) and whether the value
+// overflowed. A sign not followed by a digit does not get consumed.
+func ParseInt32Prefix(s string) (i int32, n int, ovf bool) {
+	neg, off := parseSign(s)
+	if !neg {
+		u, n, ovf := parseUintPrefix(s[off:], int32Max)
+		return int32(u), consumed(off, n), ovf
+	}
+
+	u, n, ovf := parseUintPrefix(s[off:], -int32Min)
+	return -int32(u), consumed(off, n), ovf
+}
+
+// ParseInt16Prefix parses an optional leading '-' or '+' sign followed by the longest run
+// of base10 digits in s as a signed 16-bit integer.
+//
+// Returns the value, the number of bytes consumed (including the sign) and whether the value
+// overflowed. A sign not followed by a digit does not get consumed.
+func ParseInt16Prefix(s string) (i int16, n int, ovf bool) {
+	neg, off := parseSign(s)
+	if !neg {
+		u, n, ovf := parseUintPrefix(s[off:], int16Max)
+		return int16(u), consumed(off, n), ovf
+	}
+
+	u, n, ovf := parseUintPrefix(s[off:], -int16Min)
+	return -int16(u), consumed(off, n), ovf
+}
+
+// ParseInt8Prefix parses an optional leading '-' or '+' sign followed by the longest run
+// of base10 digits in s as a signed 8-bit integer.
+//
+// Returns the value, the number of bytes consumed (including the sign) and whether the value
+// overflowed. A sign not followed by a digit does not get consumed.
+func ParseInt8Prefix(s string) (i int8, n int, ovf bool) {
+	neg, off := parseSign(s)
+	if !neg {
+		u, n, ovf := parseUintPrefix(s[off:], int8Max)
+		return int8(u), consumed(off, n), ovf
+	}
+
+	u, n, ovf := parseUintPrefix(s[off:], -int8Min)
+	return -int8(u), consumed(off, n), ovf
+}
+
+// parseUintPrefix parses the longest leading run of digits in s, saturating at max.
+func parseUintPrefix(s string, max uint64) (u uint64, n int, ovf bool) {
+	var (
+		cutoff = max / 10
+		last   = byte(max % 10)
+		b      byte
+	)
+
+	for ; n < len(s); n++ {
+		if b = s[n] - '0'; b > 9 {
+			return u, n, ovf
+		}
+
+		if u > cutoff || u == cutoff && b > last {
+			// Keep consuming the remaining digits, but the value is settled.
+			u, ovf = max, true
+			continue
+		}
+
+		u = u*10 + uint64(b)
+	}
+
+	return u, n, ovf
+}
+
+// parseSign reports whether s starts with a '-' sign and the length of the sign, if any.
+//
+// Gets inlined.
+func parseSign(s string) (neg bool, n int) {
+	if len(s) > 0 {
+		switch s[0] {
+		case '-':
+			return true, 1
+		case '+':
+			return false, 1
+		}
+	}
+
+	return false, 0
+}
+
+// consumed returns the number of bytes consumed including the sign, given that a sign only
+// counts as consumed if it was followed by at least one digit.
+//
+// Gets inlined.
+func consumed(sign, digits int) int {
+	if digits == 0 {
+		return 0
+	}
+
+	return sign + digits
+}
@@ -0,0 +1,112 @@
+package chars
+
+import "testing"
+
+func TestParseUint64Prefix(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		in          string
+		expected    uint64
+		expectedN   int
+		expectedOvf bool
+	}{
+		{"empty", "", 0, 0, false},
+		{"no-digits", "abc", 0, 0, false},
+		{"digit", "9", 9, 1, false},
+		{"list", "123,456", 123, 3, false},
+		{"status", "200 OK", 200, 3, false},
+		{"max", dec64max + "x", uint64Max, 20, false},
+		{"overflow-num", "18446744073709551616 ", uint64Max, 20, true},
+		{"overflow-len", "1844674407370955161500,", uint64Max, 22, true},
+		{"sign", "+1", 0, 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualN, actualOvf := ParseUint64Prefix(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualN != c.expectedN {
+				t.Errorf("expected [%d] bytes consumed, got [%d]", c.expectedN, actualN)
+			}
+
+			if actualOvf != c.expectedOvf {
+				t.Errorf("expected overflow [%t], got [%t]", c.expectedOvf, actualOvf)
+			}
+		})
+	}
+}
+
+func TestParseInt64Prefix(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		in          string
+		expected    int64
+		expectedN   int
+		expectedOvf bool
+	}{
+		{"empty", "", 0, 0, false},
+		{"sign-only", "-x", 0, 0, false},
+		{"plus", "+12;", 12, 3, false},
+		{"neg", "-12;", -12, 3, false},
+		{"min", deci64min + "!", int64Min, 20, false},
+		{"max", deci64max, int64Max, 19, false},
+		{"overflow-min", "-9223372036854775809", int64Min, 20, true},
+		{"overflow-max", "9223372036854775808", int64Max, 19, true},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualN, actualOvf := ParseInt64Prefix(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualN != c.expectedN {
+				t.Errorf("expected [%d] bytes consumed, got [%d]", c.expectedN, actualN)
+			}
+
+			if actualOvf != c.expectedOvf {
+				t.Errorf("expected overflow [%t], got [%t]", c.expectedOvf, actualOvf)
+			}
+		})
+	}
+}
+
+func TestParsePrefixWidths(t *testing.T) {
+	if u, n, ovf := ParseUint32Prefix("4294967296 "); u != uint32Max || n != 10 || !ovf {
+		t.Errorf("expected [%d, 10, true], got [%d, %d, %t]", uint64(uint32Max), u, n, ovf)
+	}
+
+	if u, n, ovf := ParseUint16Prefix("65535/"); u != uint16Max || n != 5 || ovf {
+		t.Errorf("expected [%d, 5, false], got [%d, %d, %t]", uint16Max, u, n, ovf)
+	}
+
+	if u, n, ovf := ParseUint8Prefix("0001."); u != 1 || n != 4 || ovf {
+		t.Errorf("expected [1, 4, false], got [%d, %d, %t]", u, n, ovf)
+	}
+
+	if i, n, ovf := ParseInt32Prefix("-2147483649"); i != int32Min || n != 11 || !ovf {
+		t.Errorf("expected [%d, 11, true], got [%d, %d, %t]", int32Min, i, n, ovf)
+	}
+
+	if i, n, ovf := ParseInt16Prefix("32768"); i != int16Max || n != 5 || !ovf {
+		t.Errorf("expected [%d, 5, true], got [%d, %d, %t]", int16Max, i, n, ovf)
+	}
+
+	if i, n, ovf := ParseInt8Prefix("-128°C"); i != int8Min || n != 4 || ovf {
+		t.Errorf("expected [%d, 4, false], got [%d, %d, %t]", int8Min, i, n, ovf)
+	}
+}
+
+func BenchmarkRushParseUint64Prefix(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _, _ = ParseUint64Prefix(dec64max)
+	}
+}
@@ -0,0 +1,276 @@
+package chars
+
+import "math/bits"
+
+// Arbitrary radix (base 2 to 36) conversions.
+//
+// Bases 10 and 16 get delegated to their dedicated implementations. Power-of-two bases work
+// with shifts and masks. All other bases parse with a multiplication per digit and format by
+// first splitting the value into chunks of as many digits as fit into a uint32, so that only
+// one 64-bit division is needed per chunk and the digits within a chunk get produced by
+// (much cheaper) 32-bit divisions.
+//
+// As with base10, overflow can only ever happen at the max length of the representation of
+// uint64Max in the respective base, which is why only the last digit at that length gets
+// checked against the per-base cutoff.
+
+const (
+	radixMin = 2
+	radixMax = 36
+
+	// Enough room for uint64Max in base 2.
+	radixMaxDigits = 64
+
+	// Marks characters that are not digits in any base in radixValues.
+	radixInvalid = 0xFF
+
+	radixDigits = "0123456789abcdefghijklmnopqrstuvwxyz"
+)
+
+// radix holds the precomputed properties of a base.
+type radix struct {
+	// uint64Max / base - if a value is above that, appending another digit overflows.
+	cutoff uint64
+
+	// Number of digits of uint64Max in this base.
+	digits int
+
+	// log2(base) for power-of-two bases, 0 otherwise.
+	shift uint
+
+	// The largest power of the base that fits into a uint32 and its exponent - the size of
+	// the chunks formatting works with.
+	chunk       uint64
+	chunkDigits int
+}
+
+var radixes = func() (t [radixMax + 1]radix) {
+	for b := uint64(radixMin); b <= radixMax; b++ {
+		r := &t[b]
+		r.cutoff = uint64Max / b
+
+		for u := uint64(uint64Max); u > 0; u /= b {
+			r.digits++
+		}
+
+		if b&(b-1) == 0 {
+			r.shift = uint(bits.TrailingZeros64(b))
+		}
+
+		for r.chunk = b; r.chunk*b <= uint32Max+1; r.chunk *= b {
+			r.chunkDigits++
+		}
+
+		r.chunkDigits++
+	}
+
+	return t
+}()
+
+// radixValues maps ASCII characters to their value as a digit (0-9, then a-z or A-Z for
+// 10-35), or radixInvalid.
+var radixValues = func() (t [256]byte) {
+	for i := range t {
+		t[i] = radixInvalid
+	}
+
+	for i := byte(0); i < 10; i++ {
+		t['0'+i] = i
+	}
+
+	for i := byte(0); i < 26; i++ {
+		t['a'+i], t['A'+i] = 10+i, 10+i
+	}
+
+	return t
+}()
+
+// ParseUint64Base takes an unsigned integer encoded in the given base (2 to 36, without
+// prefix) and converts it to an unsigned 64-bit integer. Digits above 9 are the letters a
+// to z, in either case.
+//
+// The max length of the string (not counting leading zeros) is the length of uint64Max in
+// that base, e.g. 64 characters in base 2 or 13 in base 36, and the max value of the number
+// is uint64Max.
+// If either overflows, uint64Max and false get returned.
+// If the string contains characters that are not digits in the base or the base is out of
+// range, 0 and false get returned.
+func ParseUint64Base(s string, base int) (uint64, bool) {
+	switch {
+	case base == 10:
+		return ParseUint64(s)
+	case base == 16:
+		return ParseHex64(s)
+	case base < radixMin || base > radixMax:
+		return 0, false
+	}
+
+	r := &radixes[base]
+	n := len(s) - 1
+	if n < 0 {
+		return 0, false
+	}
+
+	if n >= r.digits {
+		if s[0] == '0' {
+			return ParseUint64Base(skipZeros(s), base)
+		}
+
+		return uint64Max, false
+	}
+
+	// The last digit at max length is the only one that may overflow - it gets handled
+	// separately below, after all others got checked for syntax errors.
+	ovf := 0
+	if n == r.digits-1 {
+		ovf = 1
+	}
+
+	var (
+		u uint64
+		b = byte(base)
+	)
+
+	if shift := r.shift & 7; shift != 0 {
+		for i := 0; i <= n-ovf; i++ {
+			v := radixValues[s[i]]
+			if v >= b {
+				return 0, false
+			}
+
+			u = u<<shift | uint64(v)
+		}
+	} else {
+		for i := 0; i <= n-ovf; i++ {
+			v := radixValues[s[i]]
+			if v >= b {
+				return 0, false
+			}
+
+			u = u*uint64(b) + uint64(v)
+		}
+	}
+
+	if ovf == 0 {
+		return u, true
+	}
+
+	v := radixValues[s[n]]
+	if v >= b {
+		return 0, false
+	}
+
+	// uint64Max % base is the largest last digit still allowed at the cutoff.
+	if u > r.cutoff || u == r.cutoff && uint64(v) > uint64Max-r.cutoff*uint64(b) {
+		return uint64Max, false
+	}
+
+	return u*uint64(b) + uint64(v), true
+}
+
+// CopyUint64Base copies the representation of a uint64 in the given base (2 to 36) into dst
+// up to len(dst), discarding all overflowing bytes. Digits above 9 are the lowercase
+// letters a to z.
+//
+// Returns the number of bytes copied to dst.
+//
+// Works like strconv.AppendUint(dst, u, base) but puts values starting at the beginning of
+// dst. Panics if the base is out of range, as strconv does.
+func CopyUint64Base(dst []byte, u uint64, base int) int {
+	switch {
+	case base == 10:
+		return CopyUint64(dst, u)
+	case base == 16:
+		return CopyHex64(dst, u, 0)
+	case base < radixMin || base > radixMax:
+		panic("chars: illegal CopyUint64Base base")
+	}
+
+	r := &radixes[base]
+
+	// For power-of-two bases the length is known upfront, so the digits can get written
+	// straight into dst if they fit.
+	if r.shift != 0 {
+		if n := (bits.Len64(u|1) + int(r.shift) - 1) / int(r.shift); n <= len(dst) {
+			formatUint64Base(dst[:n], u, r, uint64(base))
+			return n
+		}
+	}
+
+	var b [radixMaxDigits]byte
+
+	return copy(dst, b[formatUint64Base(b[:], u, r, uint64(base)):])
+}
+
+// AppendUint64Base appends the representation of a uint64 in the given base (2 to 36) to dst
+// and returns the extended buffer, growing it if necessary.
+//
+// Works like strconv.AppendUint(dst, u, base). Panics if the base is out of range.
+func AppendUint64Base(dst []byte, u uint64, base int) []byte {
+	if base < radixMin || base > radixMax {
+		panic("chars: illegal AppendUint64Base base")
+	}
+
+	n := radixes[base].digits
+	dst = grow(dst, n)
+	l := len(dst)
+
+	return dst[:l+CopyUint64Base(dst[l:l+n], u, base)]
+}
+
+// formatUint64Base writes the representation of u in base b, described by r, into buf,
+// ending at len(buf), which must have enough room for all digits.
+//
+// Returns the index of the first digit in buf.
+func formatUint64Base(buf []byte, u uint64, r *radix, b uint64) int {
+	i := len(buf)
+
+	// The mask tells the compiler that the shift is below 64, sparing it the check.
+	if shift := r.shift & 7; shift != 0 {
+		m := b - 1
+		for u >= b {
+			i--
+			buf[i] = radixDigits[u&m]
+			u >>= shift
+		}
+
+		i--
+		buf[i] = radixDigits[u]
+
+		return i
+	}
+
+	// Full chunks of chunkDigits digits each, zero-padded, with 32-bit divisions.
+	var (
+		b32         = uint32(b)
+		chunk       = r.chunk
+		chunkDigits = r.chunkDigits
+	)
+
+	for u >= chunk {
+		q := u / chunk
+		c := uint32(u - q*chunk)
+		u = q
+
+		for j := 0; j < chunkDigits; j++ {
+			i--
+			cq := c / b32
+			buf[i] = radixDigits[c-cq*b32]
+			c = cq
+		}
+	}
+
+	// The leading chunk without padding.
+	c := uint32(u)
+	for c >= b32 {
+		i--
+		cq := c / b32
+		buf[i] = radixDigits[c-cq*b32]
+		c = cq
+	}
+
+	i--
+	buf[i] = radixDigits[c]
+
+	return i
+}
@@ -0,0 +1,156 @@
+package chars
+
+import (
+	"math/rand"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseUint64Base(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		base       int
+		expected   uint64
+		expectedOk bool
+	}{
+		{"empty", "", 8, 0, false},
+		{"binary", "1011", 2, 11, true},
+		{"binary-max", strings.Repeat("1", 64), 2, uint64Max, true},
+		{"binary-overflow-len", "1" + strings.Repeat("0", 64), 2, uint64Max, false},
+		{"octal", "755", 8, 0o755, true},
+		{"octal-max", "1777777777777777777777", 8, uint64Max, true},
+		{"octal-overflow", "2000000000000000000000", 8, uint64Max, false},
+		{"base32-max", "fvvvvvvvvvvvv", 32, uint64Max, true},
+		{"base32-overflow", "g000000000000", 32, uint64Max, false},
+		{"ternary-max", "11112220022122120101211020120210210211220", 3, uint64Max, true},
+		{"ternary-overflow", "11112220022122120101211020120210210211221", 3, uint64Max, false},
+		{"base36", "Zz", 36, 36*35 + 35, true},
+		{"base36-max", "3w5e11264sgsf", 36, uint64Max, true},
+		{"base36-overflow", "3w5e11264sgsg", 36, uint64Max, false},
+		{"base36-overflow-big", "zzzzzzzzzzzzz", 36, uint64Max, false},
+		{"base36-overflow-len", "10000000000000", 36, uint64Max, false},
+		{"base36-leading-zeros", "00003w5e11264sgsf", 36, uint64Max, true},
+		{"binary-leading-zeros", "0000" + strings.Repeat("1", 64), 2, uint64Max, true},
+		{"decimal", dec64max, 10, uint64Max, true},
+		{"hex", hex64mid, 16, 0x4BF92F3577B34DA6, true},
+		{"syntax-digit", "12", 2, 0, false},
+		{"syntax-letter", "7a", 8, 0, false},
+		{"syntax-last", "3w5e11264sgs_", 36, 0, false},
+		{"syntax-char", "1-1", 7, 0, false},
+		{"base-low", "1", 1, 0, false},
+		{"base-high", "1", 37, 0, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseUint64Base(c.in, c.base)
+
+			if actual != c.expected {
+				t.Errorf("expected [%d], got [%d]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestCopyUint64Base(t *testing.T) {
+	for _, c := range []struct {
+		in       uint64
+		base     int
+		size     int
+		expected string
+	}{
+		{0, 2, 64, "0"},
+		{5, 2, 64, "101"},
+		{0o755, 8, 64, "755"},
+		{uint64Max, 2, 64, strings.Repeat("1", 64)},
+		{uint64Max, 36, 64, "3w5e11264sgsf"},
+		{uint64Max, 10, 64, dec64max},
+		{uint64Max, 16, 64, hex64max},
+		{uint64Max, 36, 4, "3w5e"},
+		{uint64Max, 3, 0, ""},
+	} {
+		dst := make([]byte, c.size)
+		if actual := dst[:CopyUint64Base(dst, c.in, c.base)]; string(actual) != c.expected {
+			t.Errorf("[%d]: expected [%s], got [%s]", c.base, c.expected, actual)
+		}
+	}
+}
+
+func TestCopyUint64BasePanics(t *testing.T) {
+	for _, base := range []int{-1, 0, 1, 37} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("[%d]: expected panic", base)
+				}
+			}()
+
+			_ = AppendUint64Base(nil, 1, base)
+		}()
+	}
+}
+
+func TestUint64BaseRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(7))
+
+	for i := 0; i < 100000; i++ {
+		u := rng.Uint64() >> rng.Intn(64)
+		base := radixMin + rng.Intn(radixMax-radixMin+1)
+		expected := strconv.FormatUint(u, base)
+
+		if actual := string(AppendUint64Base([]byte("x"), u, base)); actual != "x"+expected {
+			t.Fatalf("[%d]: expected [x%s], got [%s]", base, expected, actual)
+		}
+
+		if actual, ok := ParseUint64Base(strings.ToUpper(expected), base); !ok || actual != u {
+			t.Fatalf("[%s, %d]: expected [%d, true], got [%d, %t]", expected, base, u, actual, ok)
+		}
+	}
+}
+
+func BenchmarkStrconvParseUint64Base36(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = strconv.ParseUint("3w5e11264sgsf", 36, 64)
+	}
+}
+
+func BenchmarkRushParseUint64Base36(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseUint64Base("3w5e11264sgsf", 36)
+	}
+}
+
+func BenchmarkStrconvAppendUint64Base36(b *testing.B) {
+	dst := make([]byte, 0, 64)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendUint(dst, uint64Max, 36)
+	}
+}
+
+func BenchmarkRushAppendUint64Base36(b *testing.B) {
+	dst := make([]byte, 0, 64)
+	for n := 0; n < b.N; n++ {
+		_ = AppendUint64Base(dst, uint64Max, 36)
+	}
+}
+
+func BenchmarkStrconvAppendUint64Base8(b *testing.B) {
+	dst := make([]byte, 0, 64)
+	for n := 0; n < b.N; n++ {
+		_ = strconv.AppendUint(dst, uint64Max, 8)
+	}
+}
+
+func BenchmarkRushAppendUint64Base8(b *testing.B) {
+	dst := make([]byte, 0, 64)
+	for n := 0; n < b.N; n++ {
+		_ = AppendUint64Base(dst, uint64Max, 8)
+	}
+}
@@ -0,0 +1,46 @@
+//go:build amd64 && !purego
+
+package chars
+
+import "unsafe"
+
+// SSE4.1 kernel for parsing 16 digits at once, used by ParseUint64 for inputs of 16 or more
+// digits (fixed-length numeric fields, long IDs). The kernel is in simd_amd64.s.
+//
+// CPU feature detection is done through CPUID directly, which keeps the package free of
+// dependencies. The purego build tag forces the portable SWAR path on amd64 as well.
+//
+// There is no AVX2 variant, as 16 digits fit a single XMM register and anything beyond
+// that overflows a uint64 anyways.
+
+// simdEnabled reports whether parseSixteenDigits can be used on the current CPU.
+var simdEnabled = hasSSE41()
+
+func hasSSE41() bool {
+	maxID, _, _, _ := cpuid(0, 0)
+	if maxID < 1 {
+		return false
+	}
+
+	// SSSE3 for PMADDUBSW, SSE4.1 for PACKUSDW.
+	_, _, ecx, _ := cpuid(1, 0)
+	return ecx&(1<<9) != 0 && ecx&(1<<19) != 0
+}
+
+// cpuid executes the CPUID instruction with the given EAX and ECX inputs.
+func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+
+// parseSixteen converts the first 16 bytes pointed to by p from ASCII digits to their value.
+// If any of the bytes is not a digit, 0 and false get returned.
+//
+//go:noescape
+func parseSixteen(p *byte) (uint64, bool)
+
+// parseSixteenDigits converts the first 16 bytes of s (which must be at least 16 bytes long)
+// from ASCII digits to their value. If any of the bytes is not a digit, 0 and false get returned.
+//
+// Must only be called if simdEnabled is true.
+func parseSixteenDigits(s string) (uint64, bool) {
+	_ = s[15]
+	return parseSixteen(unsafe.StringData(s))
+}
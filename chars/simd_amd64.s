@@ -0,0 +1,83 @@
+//go:build amd64 && !purego
+
+#include "textflag.h"
+
+DATA simdZeros<>+0(SB)/8, $0x3030303030303030
+DATA simdZeros<>+8(SB)/8, $0x3030303030303030
+GLOBL simdZeros<>(SB), RODATA|NOPTR, $16
+
+DATA simdNines<>+0(SB)/8, $0x0909090909090909
+DATA simdNines<>+8(SB)/8, $0x0909090909090909
+GLOBL simdNines<>(SB), RODATA|NOPTR, $16
+
+// Byte pairs of (10, 1).
+DATA simdMul10<>+0(SB)/8, $0x010a010a010a010a
+DATA simdMul10<>+8(SB)/8, $0x010a010a010a010a
+GLOBL simdMul10<>(SB), RODATA|NOPTR, $16
+
+// Word pairs of (100, 1).
+DATA simdMul100<>+0(SB)/8, $0x0001006400010064
+DATA simdMul100<>+8(SB)/8, $0x0001006400010064
+GLOBL simdMul100<>(SB), RODATA|NOPTR, $16
+
+// Word pairs of (10000, 1).
+DATA simdMul10000<>+0(SB)/8, $0x0001271000012710
+DATA simdMul10000<>+8(SB)/8, $0x0001271000012710
+GLOBL simdMul10000<>(SB), RODATA|NOPTR, $16
+
+// func cpuid(eaxArg, ecxArg uint32) (eax, ebx, ecx, edx uint32)
+TEXT ·cpuid(SB), NOSPLIT, $0-24
+	MOVL eaxArg+0(FP), AX
+	MOVL ecxArg+4(FP), CX
+	CPUID
+	MOVL AX, eax+8(FP)
+	MOVL BX, ebx+12(FP)
+	MOVL CX, ecx+16(FP)
+	MOVL DX, edx+20(FP)
+	RET
+
+// func parseSixteen(p *byte) (uint64, bool)
+TEXT ·parseSixteen(SB), NOSPLIT, $0-17
+	MOVQ p+0(FP), SI
+
+	// Legacy SSE memory operands must be 16-byte aligned, which the constants
+	// aren't guaranteed to be - so they get loaded into registers first.
+	MOVOU (SI), X0
+	MOVOU simdZeros<>(SB), X1
+	MOVOU simdNines<>(SB), X2
+	PSUBB X1, X0
+
+	// All bytes are digits iff min(b, 9) == b for every (unsigned) byte.
+	MOVOU X0, X3
+	PMINUB X2, X3
+	PCMPEQB X0, X3
+	PMOVMSKB X3, AX
+	CMPL AX, $0xffff
+	JNE invalid
+
+	MOVOU simdMul10<>(SB), X1
+	MOVOU simdMul100<>(SB), X2
+	MOVOU simdMul10000<>(SB), X3
+
+	// 16 digits -> 8 pairs (words) -> 4 quads (dwords) -> packed back into words
+	// -> 2 octets (dwords), the first of which holds the 8 leading digits.
+	PMADDUBSW X1, X0
+	PMADDWL X2, X0
+	PACKUSDW X0, X0
+	PMADDWL X3, X0
+
+	MOVQ X0, AX
+	MOVQ AX, BX
+	SHRQ $32, BX
+	MOVL AX, AX
+	IMULQ $100000000, AX
+	ADDQ BX, AX
+
+	MOVQ AX, ret+8(FP)
+	MOVB $1, ret1+16(FP)
+	RET
+
+invalid:
+	MOVQ $0, ret+8(FP)
+	MOVB $0, ret1+16(FP)
+	RET
@@ -0,0 +1,73 @@
+//go:build amd64 && !purego
+
+package chars
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// parseSixteenPortable is the portable equivalent of parseSixteenDigits.
+func parseSixteenPortable(s string) (uint64, bool) {
+	hi, ok := parseEightDigits(loadEight(s))
+	if !ok {
+		return 0, false
+	}
+
+	lo, ok := parseEightDigits(loadEight(s[8:]))
+	if !ok {
+		return 0, false
+	}
+
+	return hi*1e8 + lo, true
+}
+
+func TestParseSixteenDigits(t *testing.T) {
+	if !simdEnabled {
+		t.Skip("SSE4.1 not supported on this CPU")
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	b := make([]byte, 16)
+
+	for i := 0; i < 100000; i++ {
+		for j := range b {
+			b[j] = '0' + byte(rng.Intn(10))
+		}
+
+		// Corrupt a byte in every other run, with any byte value.
+		if i&1 == 1 {
+			b[rng.Intn(16)] = byte(rng.Intn(256))
+		}
+
+		expected, expectedOk := parseSixteenPortable(string(b))
+		actual, actualOk := parseSixteenDigits(string(b))
+
+		if actual != expected || actualOk != expectedOk {
+			t.Fatalf("[%q]: expected [%d, %t], got [%d, %t]", b, expected, expectedOk, actual, actualOk)
+		}
+	}
+
+	for _, s := range []string{"0000000000000000", "9999999999999999", "1234567890123456"} {
+		expected, _ := parseSixteenPortable(s)
+		if actual, ok := parseSixteenDigits(s); actual != expected || !ok {
+			t.Errorf("[%s]: expected [%d, true], got [%d, %t]", s, expected, actual, ok)
+		}
+	}
+}
+
+func BenchmarkParseSixteenSIMD(b *testing.B) {
+	if !simdEnabled {
+		b.Skip("SSE4.1 not supported on this CPU")
+	}
+
+	for n := 0; n < b.N; n++ {
+		_, _ = parseSixteenDigits(dec64id)
+	}
+}
+
+func BenchmarkParseSixteenSWAR(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = parseSixteenPortable(dec64id)
+	}
+}
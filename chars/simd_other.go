@@ -0,0 +1,13 @@
+//go:build !amd64 || purego
+
+package chars
+
+// simdEnabled is always false on platforms without a vectorized kernel (and with the purego
+// build tag), which lets the compiler eliminate the SIMD path in ParseUint64 altogether.
+const simdEnabled = false
+
+// parseSixteenDigits is never called when simdEnabled is false, but has to exist for
+// ParseUint64 to compile.
+func parseSixteenDigits(s string) (uint64, bool) {
+	panic("chars: parseSixteenDigits called without SIMD support")
+}
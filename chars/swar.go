@@ -0,0 +1,48 @@
+package chars
+
+// SIMD-within-a-register helpers, converting 8 ASCII digits at a time using plain 64-bit
+// arithmetic (after Lemire's approach used in simdjson and fast_float).
+//
+// The bytes get assembled little-endian regardless of the platform's endianness, so the first
+// character always ends up in the lowest byte. The compiler merges the byte loads into a single
+// 64-bit load on platforms that support unaligned loads.
+
+const (
+	swarZeros = 0x3030303030303030 // '0' in every byte.
+	swarHigh  = 0x8080808080808080 // High bit of every byte.
+	swarOver9 = 0x7676767676767676 // 0x80 - 10 in every byte; sets the high bit for any byte > 9.
+
+	swarMask = 0x000000FF000000FF
+	swarMul1 = 100 + 1000000<<32
+	swarMul2 = 1 + 10000<<32
+)
+
+// loadEight assembles the first 8 bytes of s (which must be at least 8 bytes long)
+// into a uint64, little-endian.
+//
+// Gets inlined.
+func loadEight(s string) uint64 {
+	_ = s[7]
+	return uint64(s[0]) | uint64(s[1])<<8 | uint64(s[2])<<16 | uint64(s[3])<<24 |
+		uint64(s[4])<<32 | uint64(s[5])<<40 | uint64(s[6])<<48 | uint64(s[7])<<56
+}
+
+// parseEightDigits converts 8 ASCII digits as assembled by loadEight to their value.
+// If any of the bytes is not a digit, 0 and false get returned.
+//
+// Kept separate from loadEight so that both get inlined.
+func parseEightDigits(v uint64) (uint64, bool) {
+	// Any byte below '0' sets its high bit on the subtraction (the lowest such byte at least,
+	// which suffices), any byte above '9' sets it on the addition.
+	v -= swarZeros
+	if (v|(v+swarOver9))&swarHigh != 0 {
+		return 0, false
+	}
+
+	// Combine adjacent digits into pairs (in every other byte), then pairs into quads
+	// (in every other 32-bit half) and finally the two quads, each step by a multiply-add.
+	v = v*10 + v>>8
+	v = ((v&swarMask)*swarMul1 + (v>>16&swarMask)*swarMul2) >> 32
+
+	return v, true
+}
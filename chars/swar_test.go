@@ -0,0 +1,83 @@
+package chars
+
+import (
+	"math/rand"
+	"strconv"
+	"testing"
+)
+
+func TestParseEightDigits(t *testing.T) {
+	if v, ok := parseEightDigits(loadEight("12345678")); v != 12345678 || !ok {
+		t.Errorf("expected [12345678, true], got [%d, %t]", v, ok)
+	}
+
+	if v, ok := parseEightDigits(loadEight("00000000")); v != 0 || !ok {
+		t.Errorf("expected [0, true], got [%d, %t]", v, ok)
+	}
+
+	if v, ok := parseEightDigits(loadEight("99999999")); v != 99999999 || !ok {
+		t.Errorf("expected [99999999, true], got [%d, %t]", v, ok)
+	}
+
+	// Every non-digit byte in every position must get rejected.
+	for pos := 0; pos < 8; pos++ {
+		for c := 0; c < 256; c++ {
+			if c >= '0' && c <= '9' {
+				continue
+			}
+
+			b := []byte("55555555")
+			b[pos] = byte(c)
+
+			if _, ok := parseEightDigits(loadEight(string(b))); ok {
+				t.Fatalf("expected [%q] to get rejected", b)
+			}
+		}
+	}
+}
+
+func TestParseUintSWARRandom(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+
+	for i := 0; i < 100000; i++ {
+		u := rng.Uint64() >> uint(rng.Intn(64))
+		s := strconv.FormatUint(u, 10)
+
+		if v, ok := ParseUint64(s); v != u || !ok {
+			t.Fatalf("[%s]: expected [%d, true], got [%d, %t]", s, u, v, ok)
+		}
+
+		if u <= uint32Max {
+			if v, ok := ParseUint32(s); uint64(v) != u || !ok {
+				t.Fatalf("[%s]: expected [%d, true], got [%d, %t]", s, u, v, ok)
+			}
+		}
+
+		// Corrupt a single byte, which must get caught regardless of which path handles it.
+		b := []byte(s)
+		b[rng.Intn(len(b))] = byte(rng.Intn(10)) + ':'
+		if v, ok := ParseUint64(string(b)); ok {
+			t.Fatalf("[%s]: expected a syntax error, got [%d]", b, v)
+		}
+	}
+}
+
+// parseUint64Bytewise is the plain one-digit-per-iteration loop, as reference for the benchmarks
+// of the SWAR path. Lacks overflow detection, so it's only valid for inputs of up to 19 digits.
+func parseUint64Bytewise(s string) (uint64, bool) {
+	if len(s) == 0 || len(s) >= uint64Digits {
+		return 0, false
+	}
+
+	var r uint64
+	for i := 0; i < len(s); i++ {
+		b := s[i] - '0'
+		if b > 9 {
+			return 0, false
+		}
+
+		r = r*10 + uint64(b)
+	}
+
+	return r, true
+}
@@ -0,0 +1,229 @@
+package chars
+
+import "math/bits"
+
+// 128-bit integers.
+//
+// Both directions work in chunks of 19 digits (10^19 being the largest power of 10 that fits
+// into a uint64): parsing runs each chunk through ParseUint64 and accumulates them with 128-bit
+// multiplications, formatting splits the value with two 128-by-64-bit divisions and runs each
+// chunk through the same LUT-based routine as CopyUint64.
+
+// Uint128 is an unsigned 128-bit integer with the value Hi*2^64 + Lo.
+type Uint128 struct {
+	Hi, Lo uint64
+}
+
+// Int128 is a signed 128-bit integer in two's complement, with the value Hi*2^64 + Lo.
+type Int128 struct {
+	Hi int64
+	Lo uint64
+}
+
+const (
+	uint128Digits = 39
+	int128Digits  = 39
+
+	// Digits per chunk and the corresponding power of 10.
+	chunkDigits = uint64Digits - 1
+	chunkPow10  = 1e19
+)
+
+var (
+	uint128Max = Uint128{Hi: uint64Max, Lo: uint64Max}
+	int128Max  = Int128{Hi: int64Max, Lo: uint64Max}
+	int128Min  = Int128{Hi: int64Min}
+)
+
+// ParseUint128 takes an unsigned integer encoded as base10 (decimal) and converts it
+// to an unsigned 128-bit integer.
+//
+// The max length of the string is 39 characters (not counting leading zeros) and the max
+// value of the number is uint128Max (340282366920938463463374607431768211455).
+// If either overflows, uint128Max and false get returned.
+// If the string contains non-numeric ASCII characters, the zero value and false get returned.
+func ParseUint128(s string) (Uint128, bool) {
+	if len(s) <= chunkDigits {
+		u, ok := ParseUint64(s)
+		return Uint128{Lo: u}, ok
+	}
+
+	if len(s) > uint128Digits {
+		if s[0] == '0' {
+			return ParseUint128(skipZeros(s))
+		}
+
+		return uint128Max, false
+	}
+
+	// The leading chunk takes the odd digits, all others are exactly chunkDigits long and
+	// hence can't overflow in ParseUint64.
+	n := len(s) % chunkDigits
+	if n == 0 {
+		n = chunkDigits
+	}
+
+	var (
+		u   Uint128
+		ovf uint64
+	)
+
+	for ; len(s) > 0; s, n = s[n:], chunkDigits {
+		v, ok := ParseUint64(s[:n])
+		if !ok {
+			return Uint128{}, false
+		}
+
+		// u = u*10^19 + v. Only the last round can overflow, but all digits still get
+		// checked for syntax errors first, consistent with ParseUint64.
+		hi, lo := bits.Mul64(u.Lo, chunkPow10)
+		c, h := bits.Mul64(u.Hi, chunkPow10)
+		hi, carry := bits.Add64(hi, h, 0)
+		ovf |= c | carry
+
+		u.Lo, carry = bits.Add64(lo, v, 0)
+		u.Hi, carry = bits.Add64(hi, 0, carry)
+		ovf |= carry
+	}
+
+	if ovf != 0 {
+		return uint128Max, false
+	}
+
+	return u, true
+}
+
+// ParseInt128 takes a signed integer encoded as base10 (decimal) with an optional leading
+// '-' or '+' sign and converts it to a signed 128-bit integer.
+//
+// The max length of the string is 40 characters (including the sign, not counting leading
+// zeros) and the value of the number must be in the range of
+// int128Min (-170141183460469231731687303715884105728) to
+// int128Max (170141183460469231731687303715884105727).
+// If either overflows, int128Min or int128Max (depending on the sign) and false get returned.
+// If the string contains non-numeric ASCII characters (besides the leading sign) or consists
+// of only the sign, the zero value and false get returned.
+func ParseInt128(s string) (Int128, bool) {
+	if len(s) == 0 {
+		return Int128{}, false
+	}
+
+	var neg bool
+	switch s[0] {
+	case '-':
+		neg = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	u, ok := ParseUint128(s)
+	if !ok {
+		if u == (Uint128{}) {
+			// Syntax error.
+			return Int128{}, false
+		}
+
+		u = uint128Max
+	}
+
+	if neg {
+		// The magnitude of int128Min is 2^127, i.e. just the top bit.
+		if u.Hi > 1<<63 || u.Hi == 1<<63 && u.Lo != 0 {
+			return int128Min, false
+		}
+
+		u = u.neg()
+		return Int128{Hi: int64(u.Hi), Lo: u.Lo}, true
+	}
+
+	if u.Hi > int64Max {
+		return int128Max, false
+	}
+
+	return Int128{Hi: int64(u.Hi), Lo: u.Lo}, true
+}
+
+// neg returns the two's complement negation of u.
+func (u Uint128) neg() Uint128 {
+	lo, borrow := bits.Sub64(0, u.Lo, 0)
+	hi, _ := bits.Sub64(0, u.Hi, borrow)
+
+	return Uint128{Hi: hi, Lo: lo}
+}
+
+// CopyUint128 copies the base10 representation of a Uint128 into dst up to len(dst),
+// discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+func CopyUint128(dst []byte, u Uint128) int {
+	if u.Hi == 0 {
+		return CopyUint64(dst, u.Lo)
+	}
+
+	var b [uint128Digits]byte
+
+	return copy(dst, b[formatUint128(b[:], u):])
+}
+
+// CopyInt128 copies the base10 representation of an Int128 into dst up to len(dst),
+// discarding all overflowing bytes. Negative values get prefixed with '-'.
+//
+// Returns the number of bytes copied to dst.
+func CopyInt128(dst []byte, i Int128) int {
+	u := Uint128{Hi: uint64(i.Hi), Lo: i.Lo}
+	if i.Hi >= 0 {
+		return CopyUint128(dst, u)
+	}
+
+	if len(dst) == 0 {
+		return 0
+	}
+
+	dst[0] = '-'
+
+	// As with int64Min, the negation of int128Min reinterpreted as unsigned is its magnitude.
+	return 1 + CopyUint128(dst[1:], u.neg())
+}
+
+// AppendUint128 appends the base10 representation of a Uint128 to dst and returns the
+// extended buffer, growing it if necessary.
+func AppendUint128(dst []byte, u Uint128) []byte {
+	dst = grow(dst, uint128Digits)
+	n := len(dst)
+
+	return dst[:n+CopyUint128(dst[n:n+uint128Digits], u)]
+}
+
+// AppendInt128 appends the base10 representation of an Int128 to dst and returns the
+// extended buffer, growing it if necessary. Negative values get prefixed with '-'.
+func AppendInt128(dst []byte, i Int128) []byte {
+	dst = grow(dst, int128Digits+1)
+	n := len(dst)
+
+	return dst[:n+CopyInt128(dst[n:n+int128Digits+1], i)]
+}
+
+// formatUint128 writes the base10 representation of u into b, ending at len(b), which
+// must have enough room for all digits.
+//
+// Returns the index of the first digit in b.
+func formatUint128(b []byte, u Uint128) int {
+	i := len(b)
+
+	for u.Hi != 0 {
+		// u / 10^19 in two steps, since bits.Div64 needs the high word to be below the divisor.
+		var r uint64
+		u.Hi, r = bits.Div64(0, u.Hi, chunkPow10)
+		u.Lo, r = bits.Div64(r, u.Lo, chunkPow10)
+
+		// Chunks below the leading one keep their zeros.
+		end := i
+		for i = formatUint64(b[:end], r); i > end-chunkDigits; {
+			i--
+			b[i] = '0'
+		}
+	}
+
+	return formatUint64(b[:i], u.Lo)
+}
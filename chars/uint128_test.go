@@ -0,0 +1,199 @@
+package chars
+
+import (
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+const (
+	dec128max  = "340282366920938463463374607431768211455"
+	dec128mid  = "18446744073709551616"
+	deci128min = "-170141183460469231731687303715884105728"
+	deci128max = "170141183460469231731687303715884105727"
+)
+
+func TestParseUint128(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expected   Uint128
+		expectedOk bool
+	}{
+		{"empty", "", Uint128{}, false},
+		{"zero", "0", Uint128{}, true},
+		{"u64max", dec64max, Uint128{Lo: uint64Max}, true},
+		{"mid", dec128mid, Uint128{Hi: 1}, true},
+		{"chunked", "10000000000000000000", Uint128{Lo: 1e19}, true},
+		{"max", dec128max, uint128Max, true},
+		{"overflow", "340282366920938463463374607431768211456", uint128Max, false},
+		{"overflow-big", "999999999999999999999999999999999999999", uint128Max, false},
+		{"overflow-len", "1000000000000000000000000000000000000000", uint128Max, false},
+		{"leading-zeros", "0000" + dec128max, uint128Max, true},
+		{"syntax", "1844674407370955161x", Uint128{}, false},
+		{"syntax-last", "34028236692093846346337460743176821145x", Uint128{}, false},
+		{"syntax-overflow", "99999999999999999999x999999999999999999", Uint128{}, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseUint128(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%v], got [%v]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestParseInt128(t *testing.T) {
+	for _, c := range []struct {
+		name       string
+		in         string
+		expected   Int128
+		expectedOk bool
+	}{
+		{"empty", "", Int128{}, false},
+		{"sign-only", "-", Int128{}, false},
+		{"neg-one", "-1", Int128{Hi: -1, Lo: uint64Max}, true},
+		{"plus", "+" + dec128mid, Int128{Hi: 1}, true},
+		{"min", deci128min, int128Min, true},
+		{"max", deci128max, int128Max, true},
+		{"overflow-min", "-170141183460469231731687303715884105729", int128Min, false},
+		{"overflow-max", "170141183460469231731687303715884105728", int128Max, false},
+		{"overflow-uint", dec128max, int128Max, false},
+		{"overflow-len", "-1000000000000000000000000000000000000000", int128Min, false},
+		{"syntax", "-1x", Int128{}, false},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, actualOk := ParseInt128(c.in)
+
+			if actual != c.expected {
+				t.Errorf("expected [%v], got [%v]", c.expected, actual)
+			}
+
+			if actualOk != c.expectedOk {
+				t.Errorf("expected [%t], got [%t]", c.expectedOk, actualOk)
+			}
+		})
+	}
+}
+
+func TestCopyUint128(t *testing.T) {
+	for _, c := range []struct {
+		in       Uint128
+		size     int
+		expected string
+	}{
+		{Uint128{}, 39, "0"},
+		{Uint128{Lo: uint64Max}, 39, dec64max},
+		{Uint128{Hi: 1}, 39, dec128mid},
+		{Uint128{Hi: 5421010862, Lo: 7886392056514347008}, 39, "100000000000000000000000000000"},
+		{uint128Max, 39, dec128max},
+		{uint128Max, 5, "34028"},
+		{uint128Max, 0, ""},
+	} {
+		dst := make([]byte, c.size)
+		if actual := dst[:CopyUint128(dst, c.in)]; string(actual) != c.expected {
+			t.Errorf("expected [%s], got [%s]", c.expected, actual)
+		}
+
+		if c.size == 39 {
+			if actual := AppendUint128([]byte("x"), c.in); string(actual) != "x"+c.expected {
+				t.Errorf("expected [x%s], got [%s]", c.expected, actual)
+			}
+		}
+	}
+}
+
+func TestCopyInt128(t *testing.T) {
+	for _, c := range []struct {
+		in       Int128
+		expected string
+	}{
+		{Int128{}, "0"},
+		{Int128{Hi: -1, Lo: uint64Max}, "-1"},
+		{Int128{Hi: -1}, "-" + dec128mid},
+		{int128Min, deci128min},
+		{int128Max, deci128max},
+	} {
+		var dst [40]byte
+		if actual := dst[:CopyInt128(dst[:], c.in)]; string(actual) != c.expected {
+			t.Errorf("expected [%s], got [%s]", c.expected, actual)
+		}
+
+		if actual := AppendInt128(nil, c.in); string(actual) != c.expected {
+			t.Errorf("expected [%s], got [%s]", c.expected, actual)
+		}
+	}
+}
+
+// TestUint128Random checks both directions against math/big.
+func TestUint128Random(t *testing.T) {
+	rng := rand.New(rand.NewSource(4))
+
+	for i := 0; i < 100000; i++ {
+		u := Uint128{Hi: rng.Uint64() >> rng.Intn(64), Lo: rng.Uint64()}
+		b := new(big.Int).SetUint64(u.Hi)
+		b.Lsh(b, 64).Or(b, new(big.Int).SetUint64(u.Lo))
+
+		expected := b.String()
+		if actual := string(AppendUint128(nil, u)); actual != expected {
+			t.Fatalf("expected [%s], got [%s]", expected, actual)
+		}
+
+		if actual, ok := ParseUint128(expected); !ok || actual != u {
+			t.Fatalf("[%s]: expected [%v, true], got [%v, %t]", expected, u, actual, ok)
+		}
+
+		s := Int128{Hi: int64(u.Hi), Lo: u.Lo}
+		if s.Hi < 0 {
+			b.Sub(b, new(big.Int).Lsh(big.NewInt(1), 128))
+		}
+
+		expected = b.String()
+		if actual := string(AppendInt128(nil, s)); actual != expected {
+			t.Fatalf("expected [%s], got [%s]", expected, actual)
+		}
+
+		if actual, ok := ParseInt128(expected); !ok || actual != s {
+			t.Fatalf("[%s]: expected [%v, true], got [%v, %t]", expected, s, actual, ok)
+		}
+	}
+}
+
+func BenchmarkBigParseUint128(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = new(big.Int).SetString(dec128max, 10)
+	}
+}
+
+func BenchmarkRushParseUint128(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		_, _ = ParseUint128(dec128max)
+	}
+}
+
+func BenchmarkBigAppendUint128(b *testing.B) {
+	v, _ := new(big.Int).SetString(dec128max, 10)
+	dst := make([]byte, 0, 64)
+
+	for n := 0; n < b.N; n++ {
+		_ = v.Append(dst, 10)
+	}
+}
+
+func BenchmarkRushAppendUint128(b *testing.B) {
+	dst := make([]byte, 0, 64)
+	for n := 0; n < b.N; n++ {
+		_ = AppendUint128(dst, uint128Max)
+	}
+}
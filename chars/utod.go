@@ -31,9 +31,291 @@ func CopyUint64(dst []byte, u uint64) int {
 		return copySmalls(dst, uint8(u))
 	}
 
+	// With the length known upfront, the digits can get written straight into dst.
+	// Only if they don't fit do we need to go through an intermediate buffer.
+	if n := DecimalLen64(u); n <= len(dst) {
+		formatUint64(dst[:n], u)
+		return n
+	}
+
+	var b [uint64Digits]byte
+
+	return copy(dst, b[formatUint64(b[:], u):])
+}
+
+// CopyUint32 copies the base10 representation of a uint32 into dst up to len(dst),
+// discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+//
+// Works similar to strconv.AppendUint() but puts values starting at the beginning of dst
+// instead of appending and does not grow dst.
+func CopyUint32(dst []byte, u uint32) int {
+	if len(dst) == 0 {
+		return 0
+	}
+
+	if u <= smallsN {
+		return copySmalls(dst, uint8(u))
+	}
+
+	if n := DecimalLen32(u); n <= len(dst) {
+		formatUint32(dst[:n], u)
+		return n
+	}
+
+	var b [uint32Digits]byte
+
+	return copy(dst, b[formatUint32(b[:], u):])
+}
+
+// CopyUint16 copies the base10 representation of a uint16 into dst up to len(dst),
+// discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+//
+// Works similar to strconv.AppendUint() but puts values starting at the beginning of dst
+// instead of appending and does not grow dst.
+func CopyUint16(dst []byte, u uint16) int {
+	if len(dst) == 0 {
+		return 0
+	}
+
+	if u <= smallsN {
+		return copySmalls(dst, uint8(u))
+	}
+
+	if n := DecimalLen16(u); n <= len(dst) {
+		formatUint16(dst[:n], u)
+		return n
+	}
+
+	var b [uint16Digits]byte
+
+	return copy(dst, b[formatUint16(b[:], u):])
+}
+
+// CopyUint8 copies the base10 representation of a uint8 into dst up to len(dst),
+// discarding all overflowing bytes.
+//
+// Returns the number of bytes copied to dst.
+//
+// Works similar to strconv.AppendUint() but puts values starting at the beginning of dst
+// instead of appending and does not grow dst.
+func CopyUint8(dst []byte, u uint8) int {
+	if len(dst) == 0 {
+		return 0
+	}
+
+	if u <= smallsN {
+		return copySmalls(dst, u)
+	}
+
+	// We can avoid the DIV and MUL here since we're constrained
+	// by an uint8 anyways.
+	if u > 199 {
+		u -= 200
+		dst[0] = '2'
+	} else {
+		u -= 100
+		dst[0] = '1'
+	}
+
+	if len(dst) > 1 {
+		u *= 2
+		dst[1] = smalls[u]
+
+		if len(dst) > 2 {
+			dst[2] = smalls[u+1]
+			return 3
+		}
+
+		return 2
+	}
+
+	return 1
+}
+
+// CopyUint64Checked copies the base10 representation of a uint64 into dst if it fits.
+//
+// Returns the number of bytes copied to dst and true. If dst is too short, nothing gets
+// copied and the number of bytes required along with false get returned instead.
+func CopyUint64Checked(dst []byte, u uint64) (int, bool) {
+	if n := DecimalLen64(u); n > len(dst) {
+		return n, false
+	}
+
+	return CopyUint64(dst, u), true
+}
+
+// CopyUint32Checked copies the base10 representation of a uint32 into dst if it fits.
+//
+// Returns the number of bytes copied to dst and true. If dst is too short, nothing gets
+// copied and the number of bytes required along with false get returned instead.
+func CopyUint32Checked(dst []byte, u uint32) (int, bool) {
+	if n := DecimalLen32(u); n > len(dst) {
+		return n, false
+	}
+
+	return CopyUint32(dst, u), true
+}
+
+// CopyUint16Checked copies the base10 representation of a uint16 into dst if it fits.
+//
+// Returns the number of bytes copied to dst and true. If dst is too short, nothing gets
+// copied and the number of bytes required along with false get returned instead.
+func CopyUint16Checked(dst []byte, u uint16) (int, bool) {
+	if n := DecimalLen16(u); n > len(dst) {
+		return n, false
+	}
+
+	return CopyUint16(dst, u), true
+}
+
+// CopyUint8Checked copies the base10 representation of a uint8 into dst if it fits.
+//
+// Returns the number of bytes copied to dst and true. If dst is too short, nothing gets
+// copied and the number of bytes required along with false get returned instead.
+func CopyUint8Checked(dst []byte, u uint8) (int, bool) {
+	if n := DecimalLen8(u); n > len(dst) {
+		return n, false
+	}
+
+	return CopyUint8(dst, u), true
+}
+
+// CopyUint64Backward copies the base10 representation of a uint64 into dst right-aligned,
+// i.e. with the last digit at the end of dst.
+//
+// Returns the index in dst of the first digit. If dst is too short, nothing gets copied
+// and the returned index is negative, by the number of bytes missing.
+func CopyUint64Backward(dst []byte, u uint64) int {
+	if i := len(dst) - DecimalLen64(u); i < 0 {
+		return i
+	}
+
+	return formatUint64(dst, u)
+}
+
+// CopyUint32Backward copies the base10 representation of a uint32 into dst right-aligned,
+// i.e. with the last digit at the end of dst.
+//
+// Returns the index in dst of the first digit. If dst is too short, nothing gets copied
+// and the returned index is negative, by the number of bytes missing.
+func CopyUint32Backward(dst []byte, u uint32) int {
+	if i := len(dst) - DecimalLen32(u); i < 0 {
+		return i
+	}
+
+	return formatUint32(dst, u)
+}
+
+// CopyUint16Backward copies the base10 representation of a uint16 into dst right-aligned,
+// i.e. with the last digit at the end of dst.
+//
+// Returns the index in dst of the first digit. If dst is too short, nothing gets copied
+// and the returned index is negative, by the number of bytes missing.
+func CopyUint16Backward(dst []byte, u uint16) int {
+	if i := len(dst) - DecimalLen16(u); i < 0 {
+		return i
+	}
+
+	return formatUint16(dst, u)
+}
+
+// CopyUint8Backward copies the base10 representation of a uint8 into dst right-aligned,
+// i.e. with the last digit at the end of dst.
+//
+// Returns the index in dst of the first digit. If dst is too short, nothing gets copied
+// and the returned index is negative, by the number of bytes missing.
+func CopyUint8Backward(dst []byte, u uint8) int {
+	if i := len(dst) - DecimalLen8(u); i < 0 {
+		return i
+	}
+
+	return formatUint16(dst, uint16(u))
+}
+
+// AppendUint64 appends the base10 representation of a uint64 to dst and returns the extended
+// buffer, growing it if necessary.
+//
+// Works like strconv.AppendUint(dst, u, 10).
+func AppendUint64(dst []byte, u uint64) []byte {
+	if u < 10 {
+		return append(dst, '0'+byte(u))
+	}
+
+	dst = grow(dst, uint64Digits)
+	n := len(dst)
+
+	return dst[:n+CopyUint64(dst[n:n+uint64Digits], u)]
+}
+
+// AppendUint32 appends the base10 representation of a uint32 to dst and returns the extended
+// buffer, growing it if necessary.
+//
+// Works like strconv.AppendUint(dst, uint64(u), 10).
+func AppendUint32(dst []byte, u uint32) []byte {
+	if u < 10 {
+		return append(dst, '0'+byte(u))
+	}
+
+	dst = grow(dst, uint32Digits)
+	n := len(dst)
+
+	return dst[:n+CopyUint32(dst[n:n+uint32Digits], u)]
+}
+
+// AppendUint16 appends the base10 representation of a uint16 to dst and returns the extended
+// buffer, growing it if necessary.
+//
+// Works like strconv.AppendUint(dst, uint64(u), 10).
+func AppendUint16(dst []byte, u uint16) []byte {
+	if u < 10 {
+		return append(dst, '0'+byte(u))
+	}
+
+	dst = grow(dst, uint16Digits)
+	n := len(dst)
+
+	return dst[:n+CopyUint16(dst[n:n+uint16Digits], u)]
+}
+
+// AppendUint8 appends the base10 representation of a uint8 to dst and returns the extended
+// buffer, growing it if necessary.
+//
+// Works like strconv.AppendUint(dst, uint64(u), 10).
+func AppendUint8(dst []byte, u uint8) []byte {
+	if u < 10 {
+		return append(dst, '0'+u)
+	}
+
+	dst = grow(dst, uint8Digits)
+	n := len(dst)
+
+	return dst[:n+CopyUint8(dst[n:n+uint8Digits], u)]
+}
+
+// grow ensures dst has at least n bytes of spare capacity past its length, so that the Copy
+// functions can write straight into its tail. The length of the returned slice is unchanged.
+//
+// The append of a make()'d slice gets recognized by the compiler and does not allocate
+// besides the growth of dst itself.
+func grow(dst []byte, n int) []byte {
+	if cap(dst)-len(dst) < n {
+		dst = append(dst, make([]byte, n)...)[:len(dst)]
+	}
+
+	return dst
+}
+
+// formatUint64 writes the base10 representation of u into b, ending at len(b), which
+// must have enough room for all digits.
+//
+// Returns the index of the first digit in b.
+func formatUint64(b []byte, u uint64) int {
 	var (
-		i = uint64Digits
-		b [uint64Digits]byte
+		i = len(b)
 		q uint64
 	)
 
@@ -61,35 +343,23 @@ func CopyUint64(dst []byte, u uint64) int {
 		i--
 		b[i] = '0' + byte(u)
 
-		return copy(dst, b[i:])
+		return i
 	}
 
 	u *= 2
 	i -= 2
 	b[i], b[i+1] = smalls[u], smalls[u+1]
 
-	return copy(dst, b[i:])
+	return i
 }
 
-// CopyUint32 copies the base10 representation of a uint32 into dst up to len(dst),
-// discarding all overflowing bytes.
+// formatUint32 writes the base10 representation of u into b, ending at len(b), which
+// must have enough room for all digits.
 //
-// Returns the number of bytes copied to dst.
-//
-// Works similar to strconv.AppendUint() but puts values starting at the beginning of dst
-// instead of appending and does not grow dst.
-func CopyUint32(dst []byte, u uint32) int {
-	if len(dst) == 0 {
-		return 0
-	}
-
-	if u <= smallsN {
-		return copySmalls(dst, uint8(u))
-	}
-
+// Returns the index of the first digit in b.
+func formatUint32(b []byte, u uint32) int {
 	var (
-		i = uint32Digits
-		b [uint32Digits]byte
+		i = len(b)
 		q uint32
 	)
 
@@ -117,35 +387,23 @@ func CopyUint32(dst []byte, u uint32) int {
 		i--
 		b[i] = '0' + byte(u)
 
-		return copy(dst, b[i:])
+		return i
 	}
 
 	u *= 2
 	i -= 2
 	b[i], b[i+1] = smalls[u], smalls[u+1]
 
-	return copy(dst, b[i:])
+	return i
 }
 
-// CopyUint16 copies the base10 representation of a uint16 into dst up to len(dst),
-// discarding all overflowing bytes.
+// formatUint16 writes the base10 representation of u into b, ending at len(b), which
+// must have enough room for all digits.
 //
-// Returns the number of bytes copied to dst.
-//
-// Works similar to strconv.AppendUint() but puts values starting at the beginning of dst
-// instead of appending and does not grow dst.
-func CopyUint16(dst []byte, u uint16) int {
-	if len(dst) == 0 {
-		return 0
-	}
-
-	if u <= smallsN {
-		return copySmalls(dst, uint8(u))
-	}
-
+// Returns the index of the first digit in b.
+func formatUint16(b []byte, u uint16) int {
 	var (
-		i = uint16Digits
-		b [uint16Digits]byte
+		i = len(b)
 		q uint16
 	)
 
@@ -161,55 +419,14 @@ func CopyUint16(dst []byte, u uint16) int {
 		i--
 		b[i] = '0' + byte(u)
 
-		return copy(dst, b[i:])
+		return i
 	}
 
 	u *= 2
 	i -= 2
 	b[i], b[i+1] = smalls[u], smalls[u+1]
 
-	return copy(dst, b[i:])
-}
-
-// CopyUint8 copies the base10 representation of a uint8 into dst up to len(dst),
-// discarding all overflowing bytes.
-//
-// Returns the number of bytes copied to dst.
-//
-// Works similar to strconv.AppendUint() but puts values starting at the beginning of dst
-// instead of appending and does not grow dst.
-func CopyUint8(dst []byte, u uint8) int {
-	if len(dst) == 0 {
-		return 0
-	}
-
-	if u <= smallsN {
-		return copySmalls(dst, u)
-	}
-
-	// We can avoid the DIV and MUL here since we're constrained
-	// by an uint8 anyways.
-	if u > 199 {
-		u -= 200
-		dst[0] = '2'
-	} else {
-		u -= 100
-		dst[0] = '1'
-	}
-
-	if len(dst) > 1 {
-		u *= 2
-		dst[1] = smalls[u]
-
-		if len(dst) > 2 {
-			dst[2] = smalls[u+1]
-			return 3
-		}
-
-		return 2
-	}
-
-	return 1
+	return i
 }
 
 // Gets inlined.
@@ -133,6 +133,165 @@ func TestCopyUint8(t *testing.T) {
 	}
 }
 
+func TestCopyUintShort(t *testing.T) {
+	// Digits that don't fit get discarded, keeping the leading ones.
+	buf := make([]byte, 3)
+
+	if n := CopyUint64(buf, u64max); n != 3 || string(buf) != "184" {
+		t.Errorf("expected [184], got [%s]", buf[:n])
+	}
+
+	if n := CopyUint32(buf, u32max); n != 3 || string(buf) != "429" {
+		t.Errorf("expected [429], got [%s]", buf[:n])
+	}
+
+	if n := CopyUint16(buf[:2], u16max); n != 2 || string(buf[:2]) != "65" {
+		t.Errorf("expected [65], got [%s]", buf[:n])
+	}
+}
+
+func TestCopyTruncationPolicy(t *testing.T) {
+	// All widths and signs must keep the leading bytes and discard the rest.
+	check := func(t *testing.T, full string, copyFn func([]byte) int) {
+		t.Helper()
+
+		for l := 0; l <= len(full)+1; l++ {
+			buf := make([]byte, l)
+			n := copyFn(buf)
+
+			expected := full
+			if l < len(full) {
+				expected = full[:l]
+			}
+
+			if string(buf[:n]) != expected {
+				t.Errorf("[%s] into %d bytes: expected [%s], got [%s]", full, l, expected, buf[:n])
+			}
+		}
+	}
+
+	for u := 0; u <= u8max; u++ {
+		u := u
+		check(t, strconv.Itoa(u), func(b []byte) int { return CopyUint8(b, uint8(u)) })
+		check(t, strconv.Itoa(u), func(b []byte) int { return CopyUint16(b, uint16(u)) })
+		check(t, strconv.Itoa(-u), func(b []byte) int { return CopyInt16(b, int16(-u)) })
+	}
+
+	check(t, dec16max, func(b []byte) int { return CopyUint16(b, u16max) })
+	check(t, dec32max, func(b []byte) int { return CopyUint32(b, u32max) })
+	check(t, dec64max, func(b []byte) int { return CopyUint64(b, u64max) })
+	check(t, "-128", func(b []byte) int { return CopyInt8(b, int8Min) })
+	check(t, "-2147483648", func(b []byte) int { return CopyInt32(b, int32Min) })
+	check(t, "-9223372036854775808", func(b []byte) int { return CopyInt64(b, int64Min) })
+}
+
+func TestCopyUintChecked(t *testing.T) {
+	buf := make([]byte, 20)
+
+	if n, ok := CopyUint64Checked(buf[:3], 12345); n != 5 || ok {
+		t.Errorf("expected [5, false], got [%d, %t]", n, ok)
+	}
+
+	if buf[0] != 0 {
+		t.Errorf("expected dst to be left untouched, got [%s]", buf[:3])
+	}
+
+	if n, ok := CopyUint64Checked(buf, u64max); !ok || string(buf[:n]) != dec64max {
+		t.Errorf("expected [%s, true], got [%s, %t]", dec64max, buf[:n], ok)
+	}
+
+	if n, ok := CopyUint32Checked(buf[:9], u32max); n != 10 || ok {
+		t.Errorf("expected [10, false], got [%d, %t]", n, ok)
+	}
+
+	if n, ok := CopyUint16Checked(buf[:5], u16max); !ok || string(buf[:n]) != dec16max {
+		t.Errorf("expected [%s, true], got [%s, %t]", dec16max, buf[:n], ok)
+	}
+
+	if n, ok := CopyUint8Checked(buf[:0], 0); n != 1 || ok {
+		t.Errorf("expected [1, false], got [%d, %t]", n, ok)
+	}
+
+	if n, ok := CopyInt64Checked(buf[:19], int64Min); n != 20 || ok {
+		t.Errorf("expected [20, false], got [%d, %t]", n, ok)
+	}
+
+	if n, ok := CopyInt32Checked(buf, int32Min); !ok || string(buf[:n]) != deci32min {
+		t.Errorf("expected [%s, true], got [%s, %t]", deci32min, buf[:n], ok)
+	}
+
+	if n, ok := CopyInt16Checked(buf[:1], -1); n != 2 || ok {
+		t.Errorf("expected [2, false], got [%d, %t]", n, ok)
+	}
+
+	if n, ok := CopyInt8Checked(buf[:4], int8Min); !ok || string(buf[:n]) != deci8min {
+		t.Errorf("expected [%s, true], got [%s, %t]", deci8min, buf[:n], ok)
+	}
+}
+
+func TestAppendUint(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		in   uint64
+	}{
+		{"min", 0},
+		{"digit", 9},
+		{"smalls", smallsN},
+		{"u8max", u8max},
+		{"u16mid", u16mid},
+		{"u16max", u16max},
+		{"u32mid", u32mid},
+		{"u32max", u32max},
+		{"u64mid", u64mid},
+		{"u64max", u64max},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			prefix := []byte("n=")
+			expected := strconv.AppendUint(prefix, c.in, 10)
+
+			if actual := AppendUint64(prefix, c.in); !bytes.Equal(actual, expected) {
+				t.Errorf("expected [%s], got [%s]", expected, actual)
+			}
+
+			if c.in <= u32max {
+				if actual := AppendUint32(prefix, uint32(c.in)); !bytes.Equal(actual, expected) {
+					t.Errorf("expected [%s], got [%s]", expected, actual)
+				}
+			}
+
+			if c.in <= u16max {
+				if actual := AppendUint16(prefix, uint16(c.in)); !bytes.Equal(actual, expected) {
+					t.Errorf("expected [%s], got [%s]", expected, actual)
+				}
+			}
+
+			if c.in <= u8max {
+				if actual := AppendUint8(prefix, uint8(c.in)); !bytes.Equal(actual, expected) {
+					t.Errorf("expected [%s], got [%s]", expected, actual)
+				}
+			}
+		})
+	}
+}
+
+func TestAppendUint64Spare(t *testing.T) {
+	// Spare capacity must get used without clobbering the existing contents.
+	buf := make([]byte, 2, 64)
+	buf[0], buf[1] = 'a', 'b'
+
+	out := AppendUint64(buf, u64max)
+	if string(out) != "ab18446744073709551615" {
+		t.Errorf("expected [ab18446744073709551615], got [%s]", out)
+	}
+
+	if &out[0] != &buf[0] {
+		t.Error("expected dst to be reused")
+	}
+}
+
 func BenchmarkStrconvAppendUint64(b *testing.B) {
 	by := make([]byte, 0, 20)
 
@@ -267,3 +426,24 @@ func BenchmarkRushCopyUint8Tiny(b *testing.B) {
 		_ = CopyUint8(by, 9)
 	}
 }
+
+func BenchmarkRushAppendUint64(b *testing.B) {
+	by := make([]byte, 0, 20)
+	for n := 0; n < b.N; n++ {
+		_ = AppendUint64(by, uint64Max)
+	}
+}
+
+func BenchmarkRushAppendUint32(b *testing.B) {
+	by := make([]byte, 0, 10)
+	for n := 0; n < b.N; n++ {
+		_ = AppendUint32(by, uint32Max)
+	}
+}
+
+func BenchmarkRushCopyUint64Checked(b *testing.B) {
+	by := make([]byte, 20)
+	for n := 0; n < b.N; n++ {
+		_, _ = CopyUint64Checked(by, uint64Max)
+	}
+}